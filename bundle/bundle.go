@@ -0,0 +1,150 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Bundle moves all external and remote references into the components section of the spec in place
+func Bundle(spec *openapi3.T) *openapi3.T {
+	spec.InternalizeRefs(context.Background(), newNameResolver().resolve)
+	return spec
+}
+
+// nameResolver assigns a unique component name to each external reference
+type nameResolver struct {
+	names map[string]string              // collection and reference path to component name
+	taken map[string]map[string]struct{} // component names taken by external references in each collection
+}
+
+func newNameResolver() *nameResolver {
+	return &nameResolver{
+		names: map[string]string{},
+		taken: map[string]map[string]struct{}{},
+	}
+}
+
+func (resolver *nameResolver) resolve(spec *openapi3.T, ref openapi3.ComponentRef) string {
+	key := ref.CollectionName() + " " + getLocation(ref)
+	if name, ok := resolver.names[key]; ok {
+		return name
+	}
+
+	// references to components of the root spec keep their names
+	if rootRef, ok := openapi3.ReferencesComponentInRootDocument(spec, ref); ok {
+		name := path.Base(rootRef)
+		resolver.names[key] = name
+		return name
+	}
+
+	name := resolver.uniqueName(spec, ref.CollectionName(), getBaseName(ref.RefString()))
+	resolver.names[key] = name
+	return name
+}
+
+// getLocation returns the absolute location of the referenced component
+func getLocation(ref openapi3.ComponentRef) string {
+	refPath := ref.RefPath()
+	if refPath == nil {
+		return ref.RefString()
+	}
+
+	// kin-openapi may keep the '#' of a local reference inside an external file in the fragment
+	refPath.Fragment = strings.TrimPrefix(refPath.Fragment, "#")
+	refPath.RawFragment = ""
+	return refPath.String()
+}
+
+// uniqueName returns the base name or, if it is already used in the collection, the base name with the lowest free numeric suffix
+func (resolver *nameResolver) uniqueName(spec *openapi3.T, collection string, baseName string) string {
+	existing := getComponentNames(spec.Components, collection)
+	taken := resolver.taken[collection]
+	if taken == nil {
+		taken = map[string]struct{}{}
+		resolver.taken[collection] = taken
+	}
+
+	name := baseName
+	for i := 2; ; i++ {
+		_, isExisting := existing[name]
+		_, isTaken := taken[name]
+		if !isExisting && !isTaken {
+			break
+		}
+		name = fmt.Sprintf("%s_%d", baseName, i)
+	}
+
+	taken[name] = struct{}{}
+	return name
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// getBaseName returns the last segment of the JSON pointer of the reference, or the file name without extensions if the reference is to a whole file
+func getBaseName(ref string) string {
+	file, pointer, _ := strings.Cut(ref, "#")
+
+	name := path.Base(strings.TrimRight(pointer, "/"))
+	if pointer == "" || name == "/" || name == "." {
+		name = path.Base(filepath.ToSlash(file))
+		if i := strings.Index(name, "."); i > 0 {
+			name = name[:i]
+		}
+	}
+
+	name = invalidNameChars.ReplaceAllString(name, "_")
+	if name == "" {
+		return "component"
+	}
+	return name
+}
+
+func getComponentNames(components *openapi3.Components, collection string) map[string]struct{} {
+	result := map[string]struct{}{}
+	if components == nil {
+		return result
+	}
+
+	add := func(names []string) {
+		for _, name := range names {
+			result[name] = struct{}{}
+		}
+	}
+
+	switch collection {
+	case "schemas":
+		add(keys(components.Schemas))
+	case "parameters":
+		add(keys(components.Parameters))
+	case "headers":
+		add(keys(components.Headers))
+	case "requestBodies":
+		add(keys(components.RequestBodies))
+	case "responses":
+		add(keys(components.Responses))
+	case "securitySchemes":
+		add(keys(components.SecuritySchemes))
+	case "examples":
+		add(keys(components.Examples))
+	case "links":
+		add(keys(components.Links))
+	case "callbacks":
+		add(keys(components.Callbacks))
+	}
+
+	return result
+}
+
+func keys[V any, M ~map[string]V](m M) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	return result
+}
@@ -0,0 +1,101 @@
+package bundle_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/bundle"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func loadSpec(t *testing.T, path string) *openapi3.T {
+	t.Helper()
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	specInfo, err := load.NewSpecInfo(loader, load.NewSource(path))
+	require.NoError(t, err)
+	return specInfo.Spec
+}
+
+func getSchemaRef(spec *openapi3.T, path string, status int) *openapi3.SchemaRef {
+	return spec.Paths.Value(path).Get.Responses.Status(status).Value.Content["application/json"].Schema
+}
+
+// reload serializes and loads the spec to verify that it is self-contained
+func reload(t *testing.T, spec *openapi3.T) *openapi3.T {
+	t.Helper()
+	data, err := json.Marshal(spec)
+	require.NoError(t, err)
+	result, err := openapi3.NewLoader().LoadFromData(data)
+	require.NoError(t, err)
+	return result
+}
+
+func TestBundle_Names(t *testing.T) {
+	spec := bundle.Bundle(loadSpec(t, "testdata/root.yaml"))
+
+	require.ElementsMatch(t, []string{"Error", "Error_2", "Group", "Group_2", "Node", "User"}, keys(spec.Components.Schemas))
+	require.ElementsMatch(t, []string{"limit"}, keys(spec.Components.Parameters))
+
+	require.Equal(t, "#/components/schemas/Group_2", getSchemaRef(spec, "/groups", 200).Ref)
+	require.Equal(t, "#/components/schemas/Error", getSchemaRef(spec, "/groups", 400).Ref)
+	require.Equal(t, "#/components/schemas/Error_2", getSchemaRef(spec, "/groups", 500).Ref)
+	require.Equal(t, "#/components/parameters/limit", spec.Paths.Value("/groups").Get.Parameters[0].Ref)
+
+	require.NoError(t, reload(t, spec).Validate(openapi3.NewLoader().Context))
+}
+
+func TestBundle_RootComponent(t *testing.T) {
+	spec := bundle.Bundle(loadSpec(t, "testdata/root.yaml"))
+
+	// user.yaml is already a component of the root spec so it isn't copied
+	require.Equal(t, "#/components/schemas/User", getSchemaRef(spec, "/users", 200).Ref)
+	require.Equal(t, "#/components/schemas/User", spec.Components.Schemas["Group_2"].Value.Properties["owner"].Ref)
+	require.Empty(t, spec.Components.Schemas["User"].Ref)
+}
+
+func TestBundle_Circular(t *testing.T) {
+	spec := bundle.Bundle(loadSpec(t, "testdata/root.yaml"))
+	require.Equal(t, "#/components/schemas/Node", spec.Components.Schemas["Node"].Value.Properties["children"].Value.Items.Ref)
+}
+
+func TestBundle_SelfContained(t *testing.T) {
+	const path = "../data/multi-file/base/openapi/root.yaml"
+	original := loadSpec(t, path)
+	bundled := reload(t, bundle.Bundle(loadSpec(t, path)))
+	require.NoError(t, bundled.Validate(openapi3.NewLoader().Context))
+
+	d, err := diff.Get(diff.NewConfig(), original, bundled)
+	require.NoError(t, err)
+	require.Nil(t, d.PathsDiff)
+}
+
+func TestDereference(t *testing.T) {
+	spec := bundle.Dereference(bundle.Bundle(loadSpec(t, "testdata/root.yaml")))
+
+	require.Empty(t, getSchemaRef(spec, "/groups", 200).Ref)
+	require.Empty(t, getSchemaRef(spec, "/groups", 200).Value.Properties["owner"].Ref)
+	require.Empty(t, getSchemaRef(spec, "/users", 200).Ref)
+	require.Empty(t, spec.Paths.Value("/groups").Get.Parameters[0].Ref)
+	require.Equal(t, "limit", spec.Paths.Value("/groups").Get.Parameters[0].Value.Name)
+}
+
+func TestDereference_Circular(t *testing.T) {
+	spec := reload(t, bundle.Dereference(bundle.Bundle(loadSpec(t, "testdata/root.yaml"))))
+
+	node := getSchemaRef(spec, "/tree", 200)
+	require.Empty(t, node.Ref)
+	require.Equal(t, "#/components/schemas/Node", node.Value.Properties["children"].Value.Items.Ref)
+	require.Equal(t, "#/components/schemas/Node", spec.Components.Schemas["Node"].Value.Properties["children"].Value.Items.Ref)
+}
+
+func keys[V any](m map[string]V) []string {
+	result := []string{}
+	for key := range m {
+		result = append(result, key)
+	}
+	return result
+}
@@ -0,0 +1,288 @@
+package bundle
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Dereference replaces all references by the referenced components in place
+// References that would create a cycle, like a schema that contains itself, are kept so the spec remains serializable
+func Dereference(spec *openapi3.T) *openapi3.T {
+	d := dereferencer{
+		spec:      spec,
+		schemas:   map[*openapi3.Schema]visitState{},
+		callbacks: map[*openapi3.Callback]struct{}{},
+	}
+
+	// components are walked first so that the kept circular references are in components rather than in paths
+	d.components(spec.Components)
+
+	if spec.Paths != nil {
+		paths := spec.Paths.Map()
+		for _, path := range sortedKeys(paths) {
+			d.pathItem(paths[path])
+		}
+	}
+
+	return spec
+}
+
+// the spec is walked in a deterministic order so that the same references are kept in every run
+func sortedKeys[V any, M ~map[string]V](m M) []string {
+	result := keys(m)
+	sort.Strings(result)
+	return result
+}
+
+type visitState int
+
+const (
+	visiting visitState = iota + 1
+	visited
+)
+
+// dereferencer walks the spec depth-first, a schema reference to a schema which is still being visited closes a cycle and is kept
+type dereferencer struct {
+	spec      *openapi3.T
+	schemas   map[*openapi3.Schema]visitState
+	callbacks map[*openapi3.Callback]struct{}
+}
+
+func (d *dereferencer) components(components *openapi3.Components) {
+	if components == nil {
+		return
+	}
+
+	for _, name := range sortedKeys(components.Schemas) {
+		d.schema(components.Schemas[name])
+	}
+
+	for _, name := range sortedKeys(components.Parameters) {
+		d.parameter(components.Parameters[name])
+	}
+
+	for _, name := range sortedKeys(components.Headers) {
+		d.header(components.Headers[name])
+	}
+
+	for _, name := range sortedKeys(components.RequestBodies) {
+		d.requestBody(components.RequestBodies[name])
+	}
+
+	for _, name := range sortedKeys(components.Responses) {
+		d.response(components.Responses[name])
+	}
+
+	d.examples(components.Examples)
+
+	for _, name := range sortedKeys(components.Links) {
+		if link := components.Links[name]; link != nil {
+			link.Ref = ""
+		}
+	}
+
+	for _, name := range sortedKeys(components.Callbacks) {
+		d.callback(components.Callbacks[name])
+	}
+}
+
+func (d *dereferencer) pathItem(pathItem *openapi3.PathItem) {
+	if pathItem == nil {
+		return
+	}
+
+	pathItem.Ref = ""
+	d.parameters(pathItem.Parameters)
+
+	operations := pathItem.Operations()
+	for _, method := range sortedKeys(operations) {
+		d.operation(operations[method])
+	}
+}
+
+func (d *dereferencer) operation(operation *openapi3.Operation) {
+	d.parameters(operation.Parameters)
+
+	d.requestBody(operation.RequestBody)
+
+	if operation.Responses != nil {
+		responses := operation.Responses.Map()
+		for _, status := range sortedKeys(responses) {
+			d.response(responses[status])
+		}
+	}
+
+	for _, name := range sortedKeys(operation.Callbacks) {
+		d.callback(operation.Callbacks[name])
+	}
+}
+
+func (d *dereferencer) callback(callback *openapi3.CallbackRef) {
+	if callback == nil {
+		return
+	}
+
+	callback.Ref = ""
+	if callback.Value == nil {
+		return
+	}
+
+	if _, ok := d.callbacks[callback.Value]; ok {
+		return
+	}
+	d.callbacks[callback.Value] = struct{}{}
+
+	pathItems := callback.Value.Map()
+	for _, expression := range sortedKeys(pathItems) {
+		d.pathItem(pathItems[expression])
+	}
+}
+
+func (d *dereferencer) parameters(parameters openapi3.Parameters) {
+	for _, parameter := range parameters {
+		d.parameter(parameter)
+	}
+}
+
+func (d *dereferencer) parameter(parameter *openapi3.ParameterRef) {
+	if parameter == nil {
+		return
+	}
+
+	parameter.Ref = ""
+	if parameter.Value == nil {
+		return
+	}
+
+	d.schema(parameter.Value.Schema)
+	d.content(parameter.Value.Content)
+	d.examples(parameter.Value.Examples)
+}
+
+func (d *dereferencer) header(header *openapi3.HeaderRef) {
+	if header == nil {
+		return
+	}
+
+	header.Ref = ""
+	if header.Value == nil {
+		return
+	}
+
+	d.schema(header.Value.Schema)
+	d.content(header.Value.Content)
+	d.examples(header.Value.Examples)
+}
+
+func (d *dereferencer) requestBody(requestBody *openapi3.RequestBodyRef) {
+	if requestBody == nil {
+		return
+	}
+
+	requestBody.Ref = ""
+	if requestBody.Value != nil {
+		d.content(requestBody.Value.Content)
+	}
+}
+
+func (d *dereferencer) response(response *openapi3.ResponseRef) {
+	if response == nil {
+		return
+	}
+
+	response.Ref = ""
+	if response.Value == nil {
+		return
+	}
+
+	for _, name := range sortedKeys(response.Value.Headers) {
+		d.header(response.Value.Headers[name])
+	}
+
+	for _, name := range sortedKeys(response.Value.Links) {
+		if link := response.Value.Links[name]; link != nil {
+			link.Ref = ""
+		}
+	}
+
+	d.content(response.Value.Content)
+}
+
+func (d *dereferencer) content(content openapi3.Content) {
+	for _, name := range sortedKeys(content) {
+		mediaType := content[name]
+		if mediaType == nil {
+			continue
+		}
+		d.schema(mediaType.Schema)
+		d.examples(mediaType.Examples)
+	}
+}
+
+func (d *dereferencer) examples(examples openapi3.Examples) {
+	for _, name := range sortedKeys(examples) {
+		if example := examples[name]; example != nil {
+			example.Ref = ""
+		}
+	}
+}
+
+func (d *dereferencer) schema(schemaRef *openapi3.SchemaRef) {
+	if schemaRef == nil {
+		return
+	}
+
+	// the same component may be loaded more than once from external files, use a single instance to detect cycles
+	if component := d.getComponentSchema(schemaRef.Ref); component != nil {
+		schemaRef.Value = component
+	}
+
+	if schemaRef.Value == nil {
+		return
+	}
+
+	switch d.schemas[schemaRef.Value] {
+	case visiting:
+		// keep the reference to break the cycle
+		return
+	case visited:
+		schemaRef.Ref = ""
+		return
+	}
+
+	schemaRef.Ref = ""
+	schema := schemaRef.Value
+
+	d.schemas[schema] = visiting
+	defer func() { d.schemas[schema] = visited }()
+
+	for _, schemaRefs := range []openapi3.SchemaRefs{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for _, ref := range schemaRefs {
+			d.schema(ref)
+		}
+	}
+
+	for _, name := range sortedKeys(schema.Properties) {
+		d.schema(schema.Properties[name])
+	}
+
+	d.schema(schema.Not)
+	d.schema(schema.Items)
+	d.schema(schema.AdditionalProperties.Schema)
+}
+
+const schemasPrefix = "#/components/schemas/"
+
+func (d *dereferencer) getComponentSchema(ref string) *openapi3.Schema {
+	name, ok := strings.CutPrefix(ref, schemasPrefix)
+	if !ok || d.spec.Components == nil {
+		return nil
+	}
+
+	if component := d.spec.Components.Schemas[name]; component != nil {
+		return component.Value
+	}
+	return nil
+}
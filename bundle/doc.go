@@ -0,0 +1,5 @@
+/*
+Package bundle resolves external references of a multi-file spec into a single self-contained spec
+External components are moved under components with collision-free names and internal references can optionally be dereferenced
+*/
+package bundle
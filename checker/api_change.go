@@ -118,6 +118,9 @@ func (c ApiChange) GetPath() string {
 }
 
 func (c ApiChange) GetSource() string {
+	if c.Source == nil {
+		return ""
+	}
 	return c.Source.String()
 }
 
@@ -126,7 +129,7 @@ func (c ApiChange) GetSourceFile() string {
 		return c.SourceFile
 	}
 
-	if c.Source.IsFile() {
+	if c.Source != nil && c.Source.IsFile() {
 		return c.Source.String()
 	}
 
@@ -0,0 +1,59 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/flatten/allof"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/oasdiff/oasdiff/utils/specgen"
+	"github.com/stretchr/testify/require"
+)
+
+func getGeneratedDiff(b *testing.B, options specgen.Options, flattenAllOf bool) (*diff.Diff, *diff.OperationsSourcesMap) {
+	b.Helper()
+
+	options.Revision = false
+	s1 := specgen.Generate(options)
+	options.Revision = true
+	s2 := specgen.Generate(options)
+
+	if flattenAllOf {
+		var err error
+		s1, err = allof.MergeSpec(s1)
+		require.NoError(b, err)
+		s2, err = allof.MergeSpec(s2)
+		require.NoError(b, err)
+	}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), &load.SpecInfo{Url: "base.yaml", Spec: s1}, &load.SpecInfo{Url: "revision.yaml", Spec: s2})
+	require.NoError(b, err)
+
+	return d, osm
+}
+
+func benchmarkCheckBackwardCompatibility(b *testing.B, options specgen.Options, flattenAllOf bool) {
+	d, osm := getGeneratedDiff(b, options, flattenAllOf)
+	config := allChecksConfig()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for b.Loop() {
+		require.NotEmpty(b, checker.CheckBackwardCompatibility(config, d, osm))
+	}
+}
+
+func BenchmarkCheckBackwardCompatibility(b *testing.B) {
+	benchmarkCheckBackwardCompatibility(b, specgen.DefaultOptions(), false)
+}
+
+func BenchmarkCheckBackwardCompatibility_FlattenAllOf(b *testing.B) {
+	benchmarkCheckBackwardCompatibility(b, specgen.DefaultOptions(), true)
+}
+
+func BenchmarkCheckBackwardCompatibility_NoCircularRefs(b *testing.B) {
+	options := specgen.DefaultOptions()
+	options.Circular = false
+	benchmarkCheckBackwardCompatibility(b, options, false)
+}
@@ -0,0 +1,29 @@
+package checker
+
+import (
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+const (
+	APIComponentConflictId = "api-component-conflict"
+)
+
+// APIComponentConflictCheck reports components that are defined differently in several specs of a composed collection
+func APIComponentConflictCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.ComponentConflicts == nil {
+		return result
+	}
+
+	for _, conflicts := range []diff.ComponentConflicts{diffReport.ComponentConflicts.Base, diffReport.ComponentConflicts.Revision} {
+		for _, conflict := range conflicts {
+			result = append(result, ComponentChange{
+				Id:        APIComponentConflictId,
+				Level:     config.getLogLevel(APIComponentConflictId),
+				Args:      []any{"components/" + conflict.Component + "/" + conflict.Name, conflict.Source, conflict.Ignored},
+				Component: conflict.Component,
+			})
+		}
+	}
+	return result
+}
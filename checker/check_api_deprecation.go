@@ -48,13 +48,13 @@ func APIDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.Operatio
 				continue
 			}
 
-			stability, err := getStabilityLevel(op.Extensions)
+			stability, err := getOperationStabilityLevel(config, op, path)
 			if err != nil {
 				// handled in CheckBackwardCompatibility
 				continue
 			}
 
-			deprecationDays := getDeprecationDays(config, stability)
+			deprecationDays := getOperationDeprecationDays(config, op, path, stability)
 
 			sunset, ok := getSunset(op.Extensions)
 			if !ok {
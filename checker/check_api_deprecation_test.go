@@ -30,6 +30,13 @@ func allChecksConfig() *checker.Config {
 	return checker.NewConfig(checker.GetAllChecks())
 }
 
+func withOptionalCheck(t *testing.T, config *checker.Config, id string) *checker.Config {
+	t.Helper()
+	config, err := config.WithOptionalCheck(id)
+	require.NoError(t, err)
+	return config
+}
+
 // BC: deprecating an operation with a deprecation policy and an invalid sunset date is breaking
 func TestBreaking_DeprecationWithInvalidSunset(t *testing.T) {
 
@@ -0,0 +1,64 @@
+package checker
+
+import (
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+const (
+	APIOperationPathChangedId   = "api-operation-path-changed"
+	APIOperationMethodChangedId = "api-operation-method-changed"
+)
+
+// APIOperationMovedCheck reports operations that were matched by their operationId to an operation at a different path or method, see diff.Config.MatchBy
+func APIOperationMovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.EndpointDiff == nil {
+				continue
+			}
+
+			stability, err := getOperationStabilityLevel(config, operationItem.Base, path)
+			if err != nil || stability == STABILITY_ALPHA || stability == STABILITY_DRAFT {
+				continue
+			}
+
+			op := pathItem.Revision.GetOperation(operation)
+
+			if pathDiff := operationItem.EndpointDiff.PathDiff; pathDiff != nil {
+				result = append(result, NewApiChange(
+					APIOperationPathChangedId,
+					config,
+					[]any{pathDiff.From, pathDiff.To},
+					"",
+					operationsSources,
+					op,
+					operation,
+					path,
+				))
+			}
+
+			if methodDiff := operationItem.EndpointDiff.MethodDiff; methodDiff != nil {
+				result = append(result, NewApiChange(
+					APIOperationMethodChangedId,
+					config,
+					[]any{methodDiff.From, methodDiff.To},
+					"",
+					operationsSources,
+					op,
+					operation,
+					path,
+				))
+			}
+		}
+	}
+	return result
+}
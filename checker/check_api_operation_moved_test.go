@@ -0,0 +1,92 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func getMatchByOperationIdConfig() *diff.Config {
+	config := diff.NewConfig()
+	config.MatchBy = diff.MatchByOperationIdOption
+	return config
+}
+
+// BC: moving an operation to a different path or method while keeping its operationId is breaking
+func TestBreaking_OperationMoved(t *testing.T) {
+	s1, err := open("../data/match-by-operation-id/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/match-by-operation-id/revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getMatchByOperationIdConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(singleCheckConfig(checker.APIOperationMovedCheck), d, osm)
+	require.ElementsMatch(t, []checker.ApiChange{
+		{
+			Id:          checker.APIOperationPathChangedId,
+			Args:        []any{"/users", "/v2/accounts"},
+			Level:       checker.ERR,
+			Operation:   "POST",
+			Path:        "/users",
+			Source:      load.NewSource("../data/match-by-operation-id/revision.yaml"),
+			OperationId: "createUser",
+		},
+		{
+			Id:          checker.APIOperationMethodChangedId,
+			Args:        []any{"POST", "PUT"},
+			Level:       checker.ERR,
+			Operation:   "POST",
+			Path:        "/users",
+			Source:      load.NewSource("../data/match-by-operation-id/revision.yaml"),
+			OperationId: "createUser",
+		},
+		{
+			Id:          checker.APIOperationPathChangedId,
+			Args:        []any{"/users/{id}", "/v2/accounts/{accountId}"},
+			Level:       checker.ERR,
+			Operation:   "GET",
+			Path:        "/users/{id}",
+			Source:      load.NewSource("../data/match-by-operation-id/revision.yaml"),
+			OperationId: "getUser",
+		},
+	}, errs)
+}
+
+// BC: moving an operation matched by operationId is breaking only due to the move and the changes in the operation
+func TestBreaking_OperationMovedAllChecks(t *testing.T) {
+	s1, err := open("../data/match-by-operation-id/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/match-by-operation-id/revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getMatchByOperationIdConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(allChecksConfig(), d, osm)
+
+	ids := []string{}
+	for _, err := range errs {
+		ids = append(ids, err.GetId())
+	}
+	require.ElementsMatch(t, []string{checker.APIOperationPathChangedId, checker.APIOperationMethodChangedId, checker.APIOperationPathChangedId, checker.NewRequiredRequestParameterId}, ids)
+}
+
+// BC: moving a draft operation is not breaking
+func TestBreaking_OperationMovedDraft(t *testing.T) {
+	s1, err := open("../data/match-by-operation-id/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/match-by-operation-id/revision.yaml")
+	require.NoError(t, err)
+
+	s1.Spec.Paths.Value("/users").Post.Extensions = map[string]any{"x-stability-level": "draft"}
+	s2.Spec.Paths.Value("/v2/accounts").Put.Extensions = map[string]any{"x-stability-level": "draft"}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(getMatchByOperationIdConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(singleCheckConfig(checker.APIOperationMovedCheck), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, "getUser", errs[0].(checker.ApiChange).OperationId)
+}
@@ -0,0 +1,49 @@
+package checker
+
+import (
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+const (
+	APIPathRenamedId = "api-path-renamed"
+)
+
+// APIPathRenamedCheck reports the endpoints of paths that were renamed with a path renames file or the x-oasdiff-renamed-from extension
+// the other changes of renamed endpoints are reported by the relevant checks under the original path
+func APIPathRenamedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.RenameDiff == nil {
+			continue
+		}
+
+		for operation, op := range pathItem.Revision.Operations() {
+			baseOp := pathItem.Base.GetOperation(operation)
+			if baseOp == nil {
+				// added operations are reported as new endpoints
+				continue
+			}
+
+			stability, err := getOperationStabilityLevel(config, baseOp, path)
+			if err != nil || stability == STABILITY_ALPHA || stability == STABILITY_DRAFT {
+				continue
+			}
+
+			result = append(result, NewApiChange(
+				APIPathRenamedId,
+				config,
+				[]any{pathItem.RenameDiff.From, pathItem.RenameDiff.To},
+				"",
+				operationsSources,
+				op,
+				operation,
+				path,
+			))
+		}
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+// BC: renaming a path with a path renames file is breaking
+func TestBreaking_PathRenamed(t *testing.T) {
+	s1, err := open("../data/path-renames/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/path-renames/revision.yaml")
+	require.NoError(t, err)
+
+	config := diff.NewConfig()
+	config.PathRenames = diff.PathRenames{"/users/{id}": "/accounts/{accountId}"}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(config, s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(singleCheckConfig(checker.APIPathRenamedCheck), d, osm)
+	require.ElementsMatch(t, []checker.ApiChange{
+		{
+			Id:          checker.APIPathRenamedId,
+			Args:        []any{"/users/{id}", "/accounts/{accountId}"},
+			Level:       checker.ERR,
+			Operation:   "GET",
+			Path:        "/users/{id}",
+			Source:      load.NewSource("../data/path-renames/revision.yaml"),
+			OperationId: "getUser",
+		},
+		{
+			Id:          checker.APIPathRenamedId,
+			Args:        []any{"/users/{id}", "/accounts/{accountId}"},
+			Level:       checker.ERR,
+			Operation:   "DELETE",
+			Path:        "/users/{id}",
+			Source:      load.NewSource("../data/path-renames/revision.yaml"),
+			OperationId: "deleteUser",
+		},
+	}, errs)
+}
+
+// BC: renaming a path with the x-oasdiff-renamed-from extension is breaking
+func TestBreaking_PathRenamedExtension(t *testing.T) {
+	s1, err := open("../data/path-renames/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/path-renames/revision-extension.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(allChecksConfig(), d, osm)
+
+	ids := []string{}
+	for _, err := range errs {
+		ids = append(ids, err.GetId())
+	}
+	require.ElementsMatch(t, []string{checker.APIPathRenamedId, checker.APIPathRenamedId, checker.NewRequiredRequestParameterId}, ids)
+}
+
+// BC: renaming a draft path is not breaking
+func TestBreaking_PathRenamedDraft(t *testing.T) {
+	s1, err := open("../data/path-renames/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/path-renames/revision-extension.yaml")
+	require.NoError(t, err)
+
+	for _, operation := range s1.Spec.Paths.Value("/users/{id}").Operations() {
+		operation.Extensions = map[string]any{"x-stability-level": "draft"}
+	}
+	for _, operation := range s2.Spec.Paths.Value("/accounts/{accountId}").Operations() {
+		operation.Extensions = map[string]any{"x-stability-level": "draft"}
+	}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(singleCheckConfig(checker.APIPathRenamedCheck), d, osm)
+	require.Empty(t, errs)
+}
@@ -38,7 +38,7 @@ func checkRemovedPaths(pathsDiff *diff.PathsDiff, operationsSources *diff.Operat
 
 		for operation := range pathsDiff.Base.Value(path).Operations() {
 			op := pathsDiff.Base.Value(path).GetOperation(operation)
-			stability, err := getStabilityLevel(op.Extensions)
+			stability, err := getOperationStabilityLevel(config, op, path)
 			if err != nil || stability == STABILITY_ALPHA || stability == STABILITY_DRAFT {
 				continue
 			}
@@ -68,13 +68,13 @@ func APISunsetChangedCheck(diffReport *diff.Diff, operationsSources *diff.Operat
 
 			days := date.DaysSince(civil.DateOf(time.Now()))
 
-			stability, err := getStabilityLevel(opRevision.Extensions)
+			stability, err := getOperationStabilityLevel(config, opRevision, path)
 			if err != nil {
 				// handled in CheckBackwardCompatibility
 				continue
 			}
 
-			deprecationDays := getDeprecationDays(config, stability)
+			deprecationDays := getOperationDeprecationDays(config, opRevision, path, stability)
 
 			if baseDate.After(date) && days < int(deprecationDays) {
 				result = append(result, NewApiChange(
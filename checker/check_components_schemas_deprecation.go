@@ -0,0 +1,72 @@
+package checker
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+const (
+	APISchemaReactivatedId             = "api-schema-reactivated"
+	APISchemaDeprecatedSunsetMissingId = "api-schema-deprecated-sunset-missing"
+	APISchemaSunsetDateTooSmallId      = "api-schema-sunset-date-too-small"
+	APISchemaDeprecatedId              = "api-schema-deprecated"
+)
+
+func APIComponentsSchemaDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.ComponentsDiff.SchemasDiff == nil {
+		return result
+	}
+
+	for name, schemaDiff := range diffReport.ComponentsDiff.SchemasDiff.Modified {
+		if change := checkSchemaDeprecation(config, name, schemaDiff); change != nil {
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+func checkSchemaDeprecation(config *Config, name string, schemaDiff *diff.SchemaDiff) Change {
+	if schemaDiff.DeprecatedDiff == nil {
+		return nil
+	}
+
+	if schemaDiff.DeprecatedDiff.To == nil || schemaDiff.DeprecatedDiff.To == false {
+		// not breaking changes
+		return newSchemaChange(config, APISchemaReactivatedId, []any{name})
+	}
+
+	// schemas aren't bound to an operation so their stability level is taken from the schema itself
+	stability, err := getStabilityLevel(schemaDiff.Revision.Extensions)
+	if err != nil {
+		stability = STABILITY_STABLE
+	}
+
+	deprecationDays := getDeprecationDays(config, stability)
+
+	sunset, ok := getSunset(schemaDiff.Revision.Extensions)
+	if !ok {
+		// if deprecation policy is defined and sunset is missing, it's a breaking change
+		if deprecationDays > 0 {
+			return newSchemaChange(config, APISchemaDeprecatedSunsetMissingId, []any{name})
+		}
+		// not breaking changes
+		return newSchemaChange(config, APISchemaDeprecatedId, []any{name})
+	}
+
+	date, err := getSunsetDate(sunset)
+	if err != nil {
+		return newSchemaChange(config, APISchemaSunsetParseId, []any{name, err})
+	}
+
+	days := date.DaysSince(civil.DateOf(time.Now()))
+
+	if days < int(deprecationDays) {
+		return newSchemaChange(config, APISchemaSunsetDateTooSmallId, []any{name, date, deprecationDays})
+	}
+
+	// not breaking changes
+	return newSchemaChange(config, APISchemaDeprecatedId, []any{name})
+}
@@ -1,12 +1,19 @@
 package checker
 
 import (
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oasdiff/oasdiff/diff"
 )
 
 const (
-	APISchemasRemovedId = "api-schema-removed"
-	ComponentSchemas    = "schemas"
+	APISchemasRemovedId               = "api-schema-removed" // this is actually the "without deprecation" case but we leave it as is for backward compatibility
+	APISchemaRemovedWithDeprecationId = "api-schema-removed-with-deprecation"
+	APISchemaSunsetParseId            = "api-schema-sunset-parse"
+	APISchemaRemovedBeforeSunsetId    = "api-schema-removed-before-sunset"
+	ComponentSchemas                  = "schemas"
 )
 
 func APIComponentsSchemaRemovedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
@@ -16,12 +23,40 @@ func APIComponentsSchemaRemovedCheck(diffReport *diff.Diff, operationsSources *d
 	}
 
 	for _, deletedSchema := range diffReport.ComponentsDiff.SchemasDiff.Deleted {
-		result = append(result, ComponentChange{
-			Id:        APISchemasRemovedId,
-			Level:     config.getLogLevel(APISchemasRemovedId),
-			Args:      []any{deletedSchema},
-			Component: ComponentSchemas,
-		})
+		if change := checkSchemaRemoval(config, deletedSchema, diffReport.ComponentsDiff.SchemasDiff.Base[deletedSchema]); change != nil {
+			result = append(result, change)
+		}
 	}
 	return result
 }
+
+func checkSchemaRemoval(config *Config, name string, schemaRef *openapi3.SchemaRef) Change {
+	if schemaRef == nil || schemaRef.Value == nil || !schemaRef.Value.Deprecated {
+		return newSchemaChange(config, APISchemasRemovedId, []any{name})
+	}
+
+	sunset, ok := getSunset(schemaRef.Value.Extensions)
+	if !ok {
+		return newSchemaChange(config, APISchemaRemovedWithDeprecationId, []any{name})
+	}
+
+	date, err := getSunsetDate(sunset)
+	if err != nil {
+		return newSchemaChange(config, APISchemaSunsetParseId, []any{name, err})
+	}
+
+	if civil.DateOf(time.Now()).Before(date) {
+		return newSchemaChange(config, APISchemaRemovedBeforeSunsetId, []any{name, date})
+	}
+
+	return nil
+}
+
+func newSchemaChange(config *Config, id string, args []any) Change {
+	return ComponentChange{
+		Id:        id,
+		Level:     config.getLogLevel(id),
+		Args:      args,
+		Component: ComponentSchemas,
+	}
+}
@@ -0,0 +1,32 @@
+package checker
+
+import (
+	"sort"
+
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+const (
+	APISchemaRenamedId = "api-schema-renamed"
+)
+
+// APIComponentsSchemaRenamedCheck reports component schemas that were renamed, see diff.Config.SchemaRenameSimilarity
+func APIComponentsSchemaRenamedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.ComponentsDiff.SchemasDiff == nil {
+		return result
+	}
+
+	renamed := diffReport.ComponentsDiff.SchemasDiff.Renamed
+
+	names := make([]string, 0, len(renamed))
+	for name := range renamed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		result = append(result, newSchemaChange(config, APISchemaRenamedId, []any{name, renamed[name]}))
+	}
+	return result
+}
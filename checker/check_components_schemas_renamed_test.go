@@ -0,0 +1,58 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/stretchr/testify/require"
+)
+
+func schemaRenamesConfig() *diff.Config {
+	config := diff.NewConfig()
+	config.SchemaRenameSimilarity = 1
+	return config
+}
+
+// CL: renaming a schema in components
+func TestComponentsSchemaRenamed(t *testing.T) {
+	s1, err := open("../data/schema-renames/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/schema-renames/revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(schemaRenamesConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.APIComponentsSchemaRenamedCheck), d, osm, checker.INFO)
+	require.Equal(t, checker.Changes{
+		checker.ComponentChange{
+			Id:        checker.APISchemaRenamedId,
+			Args:      []any{"UserDTO", "User"},
+			Level:     checker.INFO,
+			Component: checker.ComponentSchemas,
+		},
+	}, errs)
+	require.Equal(t, "renamed the schema 'UserDTO' to 'User'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: renaming a schema in components is not breaking
+func TestBreaking_ComponentsSchemaRenamed(t *testing.T) {
+	s1, err := open("../data/schema-renames/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/schema-renames/revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(schemaRenamesConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(withOptionalCheck(t, allChecksConfig(), checker.APISchemasRemovedId), d, osm)
+
+	// only OrderDTO, which isn't identical to Order, is reported as removed
+	ids := []string{}
+	for _, err := range errs {
+		ids = append(ids, err.GetId())
+		if err.GetId() == checker.APISchemasRemovedId {
+			require.Equal(t, []any{"OrderDTO"}, err.(checker.ComponentChange).Args)
+		}
+	}
+	require.ElementsMatch(t, []string{checker.APISchemasRemovedId, checker.RequestPropertyMaxLengthSetId}, ids)
+}
@@ -0,0 +1,106 @@
+package checker
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+// propertyRemovalIds are the ids reported when a property is removed, according to its deprecation status
+type propertyRemovalIds struct {
+	withoutDeprecation string
+	withDeprecation    string
+	beforeSunset       string
+	sunsetParse        string
+}
+
+// checkPropertyRemoval returns the change for a removed property or nil if the property was removed after its sunset date
+func checkPropertyRemoval(opInfo opInfo, ids propertyRemovalIds, property *openapi3.Schema, args []any) Change {
+	if !property.Deprecated {
+		return newPropertyChange(opInfo, ids.withoutDeprecation, args)
+	}
+
+	sunset, ok := getSunset(property.Extensions)
+	if !ok {
+		return newPropertyChange(opInfo, ids.withDeprecation, args)
+	}
+
+	date, err := getSunsetDate(sunset)
+	if err != nil {
+		return newPropertyChange(opInfo, ids.sunsetParse, append(args, err))
+	}
+
+	if civil.DateOf(time.Now()).Before(date) {
+		return newPropertyChange(opInfo, ids.beforeSunset, append(args, date))
+	}
+
+	return nil
+}
+
+// propertyDeprecationIds are the ids reported when the deprecation status of a property changes
+type propertyDeprecationIds struct {
+	deprecated         string
+	reactivated        string
+	sunsetMissing      string
+	sunsetDateTooSmall string
+	sunsetParse        string
+}
+
+// checkPropertyDeprecation returns the change for a property whose deprecation status changed or nil if it didn't change
+func checkPropertyDeprecation(opInfo opInfo, ids propertyDeprecationIds, propertyDiff *diff.SchemaDiff, args []any) Change {
+	if propertyDiff.DeprecatedDiff == nil {
+		return nil
+	}
+
+	if propertyDiff.DeprecatedDiff.To == nil || propertyDiff.DeprecatedDiff.To == false {
+		// not breaking changes
+		return newPropertyChange(opInfo, ids.reactivated, args)
+	}
+
+	stability, err := getOperationStabilityLevel(opInfo.config, opInfo.operation, opInfo.path)
+	if err != nil {
+		// handled in CheckBackwardCompatibility
+		return nil
+	}
+
+	deprecationDays := getOperationDeprecationDays(opInfo.config, opInfo.operation, opInfo.path, stability)
+
+	sunset, ok := getSunset(propertyDiff.Revision.Extensions)
+	if !ok {
+		// if deprecation policy is defined and sunset is missing, it's a breaking change
+		if deprecationDays > 0 {
+			return newPropertyChange(opInfo, ids.sunsetMissing, args)
+		}
+		// not breaking changes
+		return newPropertyChange(opInfo, ids.deprecated, args)
+	}
+
+	date, err := getSunsetDate(sunset)
+	if err != nil {
+		return newPropertyChange(opInfo, ids.sunsetParse, append(args, err))
+	}
+
+	days := date.DaysSince(civil.DateOf(time.Now()))
+
+	if days < int(deprecationDays) {
+		return newPropertyChange(opInfo, ids.sunsetDateTooSmall, append(args, date, deprecationDays))
+	}
+
+	// not breaking changes
+	return newPropertyChange(opInfo, ids.deprecated, args)
+}
+
+func newPropertyChange(opInfo opInfo, id string, args []any) Change {
+	return NewApiChange(
+		id,
+		opInfo.config,
+		args,
+		"",
+		opInfo.operationsSources,
+		opInfo.operation,
+		opInfo.method,
+		opInfo.path,
+	)
+}
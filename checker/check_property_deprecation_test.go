@@ -0,0 +1,299 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/stretchr/testify/require"
+)
+
+func getIds(changes checker.Changes) []string {
+	ids := make([]string, len(changes))
+	for i, change := range changes {
+		ids[i] = change.GetId()
+	}
+	return ids
+}
+
+func findChange(t *testing.T, changes checker.Changes, id string) checker.Change {
+	t.Helper()
+	for _, change := range changes {
+		if change.GetId() == id {
+			return change
+		}
+	}
+	require.FailNow(t, "change not found", id)
+	return nil
+}
+
+// BC: deprecating a property without a deprecation policy is not breaking
+func TestBreaking_PropertyDeprecated(t *testing.T) {
+	s1, err := open(getDeprecationFile("base-property.yaml"))
+	require.NoError(t, err)
+
+	s2, err := open(getDeprecationFile("deprecated-property-future.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(allChecksConfig(), d, osm)
+	require.Empty(t, errs)
+
+	errs = checker.CheckBackwardCompatibilityUntilLevel(allChecksConfig(), d, osm, checker.INFO)
+	require.ElementsMatch(t, []string{
+		checker.RequestPropertyDeprecatedId,
+		checker.ResponsePropertyDeprecatedId,
+		checker.ResponsePropertyDeprecatedId,
+	}, getIds(errs))
+}
+
+// BC: deprecating a property with a deprecation policy but without specifying sunset date is breaking
+func TestBreaking_PropertyDeprecatedWithoutSunsetWithPolicy(t *testing.T) {
+	s1, err := open(getDeprecationFile("base-property.yaml"))
+	require.NoError(t, err)
+
+	s2, err := open(getDeprecationFile("deprecated-property-no-sunset.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(allChecksConfig().WithDeprecation(0, 30), d, osm)
+	require.ElementsMatch(t, []string{
+		checker.RequestPropertyDeprecatedSunsetMissingId,
+		checker.ResponsePropertyDeprecatedSunsetMissingId,
+		checker.ResponsePropertyDeprecatedSunsetMissingId,
+	}, getIds(errs))
+	require.Equal(t, "request property 'created' was deprecated without sunset date", findChange(t, errs, checker.RequestPropertyDeprecatedSunsetMissingId).GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: deprecating a property with an invalid sunset date is breaking
+func TestBreaking_PropertyDeprecatedWithInvalidSunset(t *testing.T) {
+	s1, err := open(getDeprecationFile("base-property.yaml"))
+	require.NoError(t, err)
+
+	s2, err := open(getDeprecationFile("deprecated-property-invalid.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(singleCheckConfig(checker.RequestPropertyDeprecationCheck), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestPropertySunsetParseId, errs[0].GetId())
+	require.Equal(t, "failed to parse sunset date for the request property 'created': 'sunset date doesn't conform with RFC3339: invalid-date'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: reactivating a property is not breaking
+func TestBreaking_PropertyReactivated(t *testing.T) {
+	s1, err := open(getDeprecationFile("deprecated-property-future.yaml"))
+	require.NoError(t, err)
+
+	s2, err := open(getDeprecationFile("base-property.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponsePropertyDeprecationCheck), d, osm, checker.INFO)
+	require.NotEmpty(t, errs)
+	require.Equal(t, checker.ResponsePropertyReactivatedId, errs[0].GetId())
+	require.Equal(t, "property 'created' in the response with the '200' status was reactivated", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: removing a deprecated property before its sunset date is breaking
+func TestBreaking_PropertyRemovedBeforeSunset(t *testing.T) {
+	s1, err := open(getDeprecationFile("deprecated-property-future.yaml"))
+	require.NoError(t, err)
+
+	s2, err := open(getDeprecationFile("sunset-property.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(allChecksConfig(), d, osm)
+	require.ElementsMatch(t, []string{
+		checker.RequestPropertyRemovedBeforeSunsetId,
+		checker.ResponsePropertyRemovedBeforeSunsetId,
+		checker.ResponsePropertyRemovedBeforeSunsetId,
+	}, getIds(errs))
+	require.Equal(t, "removed the request property 'created' before the sunset date '9999-08-10'", findChange(t, errs, checker.RequestPropertyRemovedBeforeSunsetId).GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: removing a deprecated response property before its sunset date is reported by a single check
+func TestBreaking_ResponsePropertyRemovedBeforeSunsetSingleCheck(t *testing.T) {
+	s1, err := open(getDeprecationFile("deprecated-property-future.yaml"))
+	require.NoError(t, err)
+
+	s2, err := open(getDeprecationFile("sunset-property.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponsePropertyRemovedWithDeprecationCheck), d, osm, checker.INFO)
+	require.ElementsMatch(t, []string{
+		checker.ResponsePropertyRemovedBeforeSunsetId,
+		checker.ResponsePropertyRemovedBeforeSunsetId,
+	}, getIds(errs))
+
+	errs = checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponseRequiredPropertyUpdatedCheck), d, osm, checker.INFO)
+	require.Empty(t, errs)
+}
+
+// BC: removing a deprecated write-only response property before its sunset date is not breaking
+func TestBreaking_WriteOnlyPropertyRemovedBeforeSunset(t *testing.T) {
+	s1, err := open(getDeprecationFile("deprecated-property-future.yaml"))
+	require.NoError(t, err)
+	s1.Spec.Components.Schemas["GroupView"].Value.Properties["created"].Value.WriteOnly = true
+
+	s2, err := open(getDeprecationFile("sunset-property.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(allChecksConfig(), d, osm, checker.INFO)
+	require.ElementsMatch(t, []string{
+		checker.RequestPropertyRemovedBeforeSunsetId,
+		checker.ResponseRequiredWriteOnlyPropertyRemovedId,
+		checker.ResponseRequiredWriteOnlyPropertyRemovedId,
+	}, getIds(errs))
+}
+
+// BC: removing a deprecated optional write-only response property before its sunset date is not breaking
+func TestBreaking_OptionalWriteOnlyPropertyRemovedBeforeSunset(t *testing.T) {
+	s1, err := open(getDeprecationFile("deprecated-property-future.yaml"))
+	require.NoError(t, err)
+	s1.Spec.Components.Schemas["GroupView"].Value.Properties["created"].Value.WriteOnly = true
+	s1.Spec.Components.Schemas["GroupView"].Value.Required = []string{"name"}
+
+	s2, err := open(getDeprecationFile("sunset-property.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponseOptionalPropertyUpdatedCheck), d, osm, checker.INFO)
+	require.ElementsMatch(t, []string{
+		checker.ResponseOptionalWriteOnlyPropertyRemovedId,
+		checker.ResponseOptionalWriteOnlyPropertyRemovedId,
+	}, getIds(errs))
+	for _, err := range errs {
+		require.Equal(t, checker.INFO, err.GetLevel())
+	}
+
+	errs = checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.ResponsePropertyRemovedWithDeprecationCheck), d, osm, checker.INFO)
+	require.Empty(t, errs)
+}
+
+// BC: removing a deprecated property after its sunset date is not breaking
+func TestBreaking_PropertyRemovedAfterSunset(t *testing.T) {
+	s1, err := open(getDeprecationFile("deprecated-property-past.yaml"))
+	require.NoError(t, err)
+
+	s2, err := open(getDeprecationFile("sunset-property.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(allChecksConfig(), d, osm)
+	require.Empty(t, errs)
+}
+
+// BC: removing a deprecated property without a sunset date is not breaking
+func TestBreaking_PropertyRemovedWithDeprecation(t *testing.T) {
+	s1, err := open(getDeprecationFile("deprecated-property-no-sunset.yaml"))
+	require.NoError(t, err)
+
+	s2, err := open(getDeprecationFile("sunset-property.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(allChecksConfig(), d, osm)
+	require.Empty(t, errs)
+
+	errs = checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.RequestPropertyUpdatedCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestPropertyRemovedWithDeprecationId, errs[0].GetId())
+}
+
+// BC: removing a property without deprecation is breaking
+func TestBreaking_PropertyRemovedWithoutDeprecation(t *testing.T) {
+	s1, err := open(getDeprecationFile("base-property.yaml"))
+	require.NoError(t, err)
+
+	s2, err := open(getDeprecationFile("sunset-property.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(allChecksConfig(), d, osm)
+	require.ElementsMatch(t, []string{
+		checker.RequestPropertyRemovedId,
+		checker.ResponseRequiredPropertyRemovedId,
+		checker.ResponseRequiredPropertyRemovedId,
+	}, getIds(errs))
+}
+
+// BC: removing a deprecated schema before its sunset date is breaking (optional)
+func TestBreaking_SchemaRemovedBeforeSunset(t *testing.T) {
+	s1, err := open(getDeprecationFile("base-property.yaml"))
+	require.NoError(t, err)
+	s1.Spec.Components.Schemas["OldView"] = &openapi3.SchemaRef{Value: &openapi3.Schema{
+		Deprecated: true,
+		Extensions: map[string]any{"x-sunset": "9999-08-10"},
+	}}
+
+	s2, err := open(getDeprecationFile("base-property.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(allChecksConfig(), d, osm)
+	require.Empty(t, errs)
+
+	errs = checker.CheckBackwardCompatibility(withOptionalCheck(t, allChecksConfig(), checker.APISchemaRemovedBeforeSunsetId), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.APISchemaRemovedBeforeSunsetId, errs[0].GetId())
+	require.Equal(t, checker.ComponentSchemas, errs[0].(checker.ComponentChange).Component)
+	require.Equal(t, "removed the schema 'OldView' before the sunset date '9999-08-10'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// BC: removing a deprecated schema after its sunset date is not breaking
+func TestBreaking_SchemaRemovedAfterSunset(t *testing.T) {
+	s1, err := open(getDeprecationFile("base-property.yaml"))
+	require.NoError(t, err)
+	s1.Spec.Components.Schemas["OldView"] = &openapi3.SchemaRef{Value: &openapi3.Schema{
+		Deprecated: true,
+		Extensions: map[string]any{"x-sunset": "2022-08-10"},
+	}}
+
+	s2, err := open(getDeprecationFile("base-property.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.APIComponentsSchemaRemovedCheck), d, osm, checker.INFO)
+	require.Empty(t, errs)
+}
+
+// BC: deprecating a schema with a deprecation policy but without specifying sunset date is breaking (optional)
+func TestBreaking_SchemaDeprecatedWithoutSunsetWithPolicy(t *testing.T) {
+	s1, err := open(getDeprecationFile("base-property.yaml"))
+	require.NoError(t, err)
+
+	s2, err := open(getDeprecationFile("base-property.yaml"))
+	require.NoError(t, err)
+	s2.Spec.Components.Schemas["GroupView"].Value.Deprecated = true
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.APIComponentsSchemaDeprecationCheck), d, osm, checker.INFO)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.APISchemaDeprecatedId, errs[0].GetId())
+	require.Equal(t, "schema 'GroupView' was deprecated", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+
+	c := withOptionalCheck(t, singleCheckConfig(checker.APIComponentsSchemaDeprecationCheck).WithDeprecation(0, 30), checker.APISchemaDeprecatedSunsetMissingId)
+	errs = checker.CheckBackwardCompatibility(c, d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.APISchemaDeprecatedSunsetMissingId, errs[0].GetId())
+}
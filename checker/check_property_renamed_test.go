@@ -0,0 +1,83 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func getPropertyRenamesDiff(t *testing.T, detectPropertyRenames bool) (*diff.Diff, *diff.OperationsSourcesMap) {
+	t.Helper()
+	s1, err := open("../data/property-renames/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/property-renames/revision.yaml")
+	require.NoError(t, err)
+
+	config := diff.NewConfig()
+	config.DetectPropertyRenames = detectPropertyRenames
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(config, s1, s2)
+	require.NoError(t, err)
+	return d, osm
+}
+
+// BC: renaming a request property is breaking
+func TestBreaking_RequestPropertyRenamed(t *testing.T) {
+	d, osm := getPropertyRenamesDiff(t, true)
+	errs := checker.CheckBackwardCompatibility(singleCheckConfig(checker.RequestPropertyRenamedCheck), d, osm)
+	require.Equal(t, checker.Changes{
+		checker.ApiChange{
+			Id:          checker.RequestPropertyRenamedId,
+			Args:        []any{"userName", "username"},
+			Level:       checker.ERR,
+			Operation:   "POST",
+			Path:        "/users",
+			Source:      load.NewSource("../data/property-renames/revision.yaml"),
+			OperationId: "createUser",
+		},
+	}, errs)
+}
+
+// BC: renaming a response property is breaking
+func TestBreaking_ResponsePropertyRenamed(t *testing.T) {
+	d, osm := getPropertyRenamesDiff(t, true)
+	errs := checker.CheckBackwardCompatibility(singleCheckConfig(checker.ResponsePropertyRenamedCheck), d, osm)
+	require.Equal(t, checker.Changes{
+		checker.ApiChange{
+			Id:          checker.ResponsePropertyRenamedId,
+			Args:        []any{"address/zip", "address/postalCode", "200"},
+			Level:       checker.ERR,
+			Operation:   "POST",
+			Path:        "/users",
+			Source:      load.NewSource("../data/property-renames/revision.yaml"),
+			OperationId: "createUser",
+		},
+	}, errs)
+	require.Equal(t, "renamed the property 'address/zip' to 'address/postalCode' in the response with the '200' status", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+// CL: renaming request and response properties
+func TestPropertyRenamed_Changelog(t *testing.T) {
+	d, osm := getPropertyRenamesDiff(t, true)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(allChecksConfig(), d, osm, checker.INFO)
+
+	ids := []string{}
+	for _, err := range errs {
+		ids = append(ids, err.GetId())
+	}
+	require.ElementsMatch(t, []string{checker.RequestPropertyRenamedId, checker.ResponsePropertyRenamedId}, ids)
+}
+
+func TestPropertyRenamed_Disabled(t *testing.T) {
+	d, osm := getPropertyRenamesDiff(t, false)
+	errs := checker.CheckBackwardCompatibilityUntilLevel(allChecksConfig(), d, osm, checker.INFO)
+
+	ids := []string{}
+	for _, err := range errs {
+		ids = append(ids, err.GetId())
+	}
+	require.ElementsMatch(t, []string{checker.NewRequiredRequestPropertyId, checker.RequestPropertyRemovedId, checker.ResponseOptionalPropertyRemovedId, checker.ResponseOptionalPropertyAddedId}, ids)
+}
@@ -57,13 +57,13 @@ func RequestParameterDeprecationCheck(diffReport *diff.Diff, operationsSources *
 						continue
 					}
 
-					stability, err := getStabilityLevel(op.Extensions)
+					stability, err := getOperationStabilityLevel(config, op, path)
 					if err != nil {
 						// handled in CheckBackwardCompatibility
 						continue
 					}
 
-					deprecationDays := getDeprecationDays(config, stability)
+					deprecationDays := getOperationDeprecationDays(config, op, path, stability)
 
 					sunset, ok := getSunset(param.Extensions)
 					if !ok {
@@ -78,13 +78,13 @@ func RequestParameterSunsetChangedCheck(diffReport *diff.Diff, operationsSources
 
 					days := date.DaysSince(civil.DateOf(time.Now()))
 
-					stability, err := getStabilityLevel(opRevision.Extensions)
+					stability, err := getOperationStabilityLevel(config, opRevision, path)
 					if err != nil {
 						// handled in CheckBackwardCompatibility
 						continue
 					}
 
-					deprecationDays := getDeprecationDays(config, stability)
+					deprecationDays := getOperationDeprecationDays(config, opRevision, path, stability)
 
 					if baseDate.After(date) && days < int(deprecationDays) {
 						result = append(result, NewApiChange(
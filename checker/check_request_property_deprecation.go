@@ -0,0 +1,59 @@
+package checker
+
+import (
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+const (
+	RequestPropertyReactivatedId             = "request-property-reactivated"
+	RequestPropertyDeprecatedSunsetMissingId = "request-property-deprecated-sunset-missing"
+	RequestPropertySunsetDateTooSmallId      = "request-property-sunset-date-too-small"
+	RequestPropertyDeprecatedId              = "request-property-deprecated"
+)
+
+var requestPropertyDeprecationIds = propertyDeprecationIds{
+	deprecated:         RequestPropertyDeprecatedId,
+	reactivated:        RequestPropertyReactivatedId,
+	sunsetMissing:      RequestPropertyDeprecatedSunsetMissingId,
+	sunsetDateTooSmall: RequestPropertySunsetDateTooSmallId,
+	sunsetParse:        RequestPropertySunsetParseId,
+}
+
+func RequestPropertyDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.RequestBodyDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified == nil {
+				continue
+			}
+
+			opInfo := newOpInfo(config, operationItem.Revision, operationsSources, operation, path)
+
+			modifiedMediaTypes := operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified
+			for _, mediaTypeDiff := range modifiedMediaTypes {
+				CheckModifiedPropertiesDiff(
+					mediaTypeDiff.SchemaDiff,
+					func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+						if propertyName == "" || propertyDiff.Revision.ReadOnly {
+							return
+						}
+
+						if change := checkPropertyDeprecation(opInfo, requestPropertyDeprecationIds, propertyDiff, []any{propertyFullName(propertyPath, propertyName)}); change != nil {
+							result = append(result, change)
+						}
+					})
+			}
+		}
+	}
+
+	return result
+}
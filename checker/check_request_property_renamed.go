@@ -0,0 +1,51 @@
+package checker
+
+import (
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+const (
+	RequestPropertyRenamedId = "request-property-renamed"
+)
+
+// RequestPropertyRenamedCheck reports request properties that were renamed, see diff.Config.DetectPropertyRenames
+func RequestPropertyRenamedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.RequestBodyDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff == nil ||
+				operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified == nil {
+				continue
+			}
+			modifiedMediaTypes := operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified
+			for _, mediaTypeDiff := range modifiedMediaTypes {
+				CheckRenamedPropertiesDiff(
+					mediaTypeDiff.SchemaDiff,
+					func(propertyPath string, propertyName1 string, propertyName2 string, parent *diff.SchemaDiff) {
+						if parent.Revision.Properties[propertyName2].Value.ReadOnly {
+							return
+						}
+
+						result = append(result, NewApiChange(
+							RequestPropertyRenamedId,
+							config,
+							[]any{propertyFullName(propertyPath, propertyName1), propertyFullName(propertyPath, propertyName2)},
+							"",
+							operationsSources,
+							operationItem.Revision,
+							operation,
+							path,
+						))
+					})
+			}
+		}
+	}
+	return result
+}
@@ -7,12 +7,22 @@ import (
 )
 
 const (
-	RequestPropertyRemovedId                = "request-property-removed"
+	RequestPropertyRemovedId                = "request-property-removed" // this is actually the "without deprecation" case but we leave it as is for backward compatibility
+	RequestPropertyRemovedWithDeprecationId = "request-property-removed-with-deprecation"
+	RequestPropertySunsetParseId            = "request-property-sunset-parse"
+	RequestPropertyRemovedBeforeSunsetId    = "request-property-removed-before-sunset"
 	NewRequiredRequestPropertyId            = "new-required-request-property"
 	NewRequiredRequestPropertyWithDefaultId = "new-required-request-property-with-default"
 	NewOptionalRequestPropertyId            = "new-optional-request-property"
 )
 
+var requestPropertyRemovalIds = propertyRemovalIds{
+	withoutDeprecation: RequestPropertyRemovedId,
+	withDeprecation:    RequestPropertyRemovedWithDeprecationId,
+	beforeSunset:       RequestPropertyRemovedBeforeSunsetId,
+	sunsetParse:        RequestPropertySunsetParseId,
+}
+
 func RequestPropertyUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
 	result := make(Changes, 0)
 	if diffReport.PathsDiff == nil {
@@ -33,18 +43,13 @@ func RequestPropertyUpdatedCheck(diffReport *diff.Diff, operationsSources *diff.
 				CheckDeletedPropertiesDiff(
 					mediaTypeDiff.SchemaDiff,
 					func(propertyPath string, propertyName string, propertyItem *openapi3.Schema, parent *diff.SchemaDiff) {
-						if !propertyItem.ReadOnly {
+						if propertyItem.ReadOnly {
+							return
+						}
 
-							result = append(result, NewApiChange(
-								RequestPropertyRemovedId,
-								config,
-								[]any{propertyFullName(propertyPath, propertyName)},
-								"",
-								operationsSources,
-								operationItem.Revision,
-								operation,
-								path,
-							))
+						opInfo := newOpInfo(config, operationItem.Revision, operationsSources, operation, path)
+						if change := checkPropertyRemoval(opInfo, requestPropertyRemovalIds, propertyItem, []any{propertyFullName(propertyPath, propertyName)}); change != nil {
+							result = append(result, change)
 						}
 					})
 				CheckAddedPropertiesDiff(
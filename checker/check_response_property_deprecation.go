@@ -0,0 +1,65 @@
+package checker
+
+import (
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+const (
+	ResponsePropertyReactivatedId             = "response-property-reactivated"
+	ResponsePropertyDeprecatedSunsetMissingId = "response-property-deprecated-sunset-missing"
+	ResponsePropertySunsetDateTooSmallId      = "response-property-sunset-date-too-small"
+	ResponsePropertyDeprecatedId              = "response-property-deprecated"
+	ResponsePropertySunsetParseId             = "response-property-sunset-parse"
+)
+
+var responsePropertyDeprecationIds = propertyDeprecationIds{
+	deprecated:         ResponsePropertyDeprecatedId,
+	reactivated:        ResponsePropertyReactivatedId,
+	sunsetMissing:      ResponsePropertyDeprecatedSunsetMissingId,
+	sunsetDateTooSmall: ResponsePropertySunsetDateTooSmallId,
+	sunsetParse:        ResponsePropertySunsetParseId,
+}
+
+func ResponsePropertyDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+			if operationItem.ResponsesDiff == nil {
+				continue
+			}
+
+			opInfo := newOpInfo(config, operationItem.Revision, operationsSources, operation, path)
+
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff.ContentDiff == nil ||
+					responseDiff.ContentDiff.MediaTypeModified == nil {
+					continue
+				}
+
+				modifiedMediaTypes := responseDiff.ContentDiff.MediaTypeModified
+				for _, mediaTypeDiff := range modifiedMediaTypes {
+					CheckModifiedPropertiesDiff(
+						mediaTypeDiff.SchemaDiff,
+						func(propertyPath string, propertyName string, propertyDiff *diff.SchemaDiff, parent *diff.SchemaDiff) {
+							if propertyName == "" || propertyDiff.Revision.WriteOnly {
+								return
+							}
+
+							if change := checkPropertyDeprecation(opInfo, responsePropertyDeprecationIds, propertyDiff, []any{propertyFullName(propertyPath, propertyName), responseStatus}); change != nil {
+								result = append(result, change)
+							}
+						})
+				}
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,63 @@
+package checker
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+const (
+	ResponsePropertyRemovedWithDeprecationId = "response-property-removed-with-deprecation"
+	ResponsePropertyRemovedBeforeSunsetId    = "response-property-removed-before-sunset"
+)
+
+// responsePropertyRemovalIds are the ids for removing a deprecated response property
+// Removing a property that isn't deprecated is reported by ResponseOptionalPropertyUpdatedCheck and ResponseRequiredPropertyUpdatedCheck
+var responsePropertyRemovalIds = propertyRemovalIds{
+	withDeprecation: ResponsePropertyRemovedWithDeprecationId,
+	beforeSunset:    ResponsePropertyRemovedBeforeSunsetId,
+	sunsetParse:     ResponsePropertySunsetParseId,
+}
+
+func ResponsePropertyRemovedWithDeprecationCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+
+			if operationItem.ResponsesDiff == nil {
+				continue
+			}
+
+			opInfo := newOpInfo(config, operationItem.Revision, operationsSources, operation, path)
+
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff.ContentDiff == nil ||
+					responseDiff.ContentDiff.MediaTypeModified == nil {
+					continue
+				}
+
+				modifiedMediaTypes := responseDiff.ContentDiff.MediaTypeModified
+				for _, mediaTypeDiff := range modifiedMediaTypes {
+					CheckDeletedPropertiesDiff(
+						mediaTypeDiff.SchemaDiff,
+						func(propertyPath string, propertyName string, propertyItem *openapi3.Schema, parent *diff.SchemaDiff) {
+							if !propertyItem.Deprecated || propertyItem.WriteOnly {
+								// write-only properties aren't returned in responses, so removing them is covered by the write-only ids of the optional and required property checks
+								return
+							}
+
+							if change := checkPropertyRemoval(opInfo, responsePropertyRemovalIds, propertyItem, []any{propertyFullName(propertyPath, propertyName), responseStatus}); change != nil {
+								result = append(result, change)
+							}
+						})
+				}
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,58 @@
+package checker
+
+import (
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+const (
+	ResponsePropertyRenamedId = "response-property-renamed"
+)
+
+// ResponsePropertyRenamedCheck reports response properties that were renamed, see diff.Config.DetectPropertyRenames
+func ResponsePropertyRenamedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+
+			if operationItem.ResponsesDiff == nil {
+				continue
+			}
+
+			for responseStatus, responseDiff := range operationItem.ResponsesDiff.Modified {
+				if responseDiff.ContentDiff == nil ||
+					responseDiff.ContentDiff.MediaTypeModified == nil {
+					continue
+				}
+
+				modifiedMediaTypes := responseDiff.ContentDiff.MediaTypeModified
+				for _, mediaTypeDiff := range modifiedMediaTypes {
+					CheckRenamedPropertiesDiff(
+						mediaTypeDiff.SchemaDiff,
+						func(propertyPath string, propertyName1 string, propertyName2 string, parent *diff.SchemaDiff) {
+							if parent.Revision.Properties[propertyName2].Value.WriteOnly {
+								return
+							}
+
+							result = append(result, NewApiChange(
+								ResponsePropertyRenamedId,
+								config,
+								[]any{propertyFullName(propertyPath, propertyName1), propertyFullName(propertyPath, propertyName2), responseStatus},
+								"",
+								operationsSources,
+								operationItem.Revision,
+								operation,
+								path,
+							))
+						})
+				}
+			}
+		}
+	}
+	return result
+}
@@ -48,6 +48,11 @@ func ResponseRequiredPropertyUpdatedCheck(diffReport *diff.Diff, operationsSourc
 								return
 							}
 
+							if propertyItem.Deprecated && !propertyItem.WriteOnly {
+								// covered by ResponsePropertyRemovedWithDeprecationCheck
+								return
+							}
+
 							result = append(result, NewApiChange(
 								id,
 								config,
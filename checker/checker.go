@@ -4,6 +4,7 @@ package checker
 import (
 	"encoding/json"
 	"fmt"
+	"iter"
 	"sort"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -22,30 +23,56 @@ func CheckBackwardCompatibility(config *Config, diffReport *diff.Diff, operation
 // CheckBackwardCompatibilityUntilLevel runs the checks with level equal or higher than the given level
 func CheckBackwardCompatibilityUntilLevel(config *Config, diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, level Level) Changes {
 	result := make(Changes, 0)
-
-	if diffReport == nil {
-		return result
+	for change := range CheckBackwardCompatibilityUntilLevelSeq(config, diffReport, operationsSources, level) {
+		result = append(result, change)
 	}
 
-	result = removeDraftAndAlphaOperationsDiffs(config, diffReport, result, operationsSources)
+	sort.Sort(result)
+	return result
+}
 
-	for _, check := range config.Checks {
-		if check == nil {
-			continue
+// CheckBackwardCompatibilityUntilLevelSeq is like CheckBackwardCompatibilityUntilLevel but yields the changes check by check instead of collecting all of them
+// This allows to process huge diffs without holding all of their changes in memory
+// The changes of each check are sorted, but the changes of different checks are yielded in the order of the checks
+// Note that the checks modify the diff report, so the sequence should only be iterated once
+func CheckBackwardCompatibilityUntilLevelSeq(config *Config, diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, level Level) iter.Seq[Change] {
+	return func(yield func(Change) bool) {
+		if diffReport == nil {
+			return
+		}
+
+		if !yieldChanges(config, removeDraftAndAlphaOperationsDiffs(config, diffReport, make(Changes, 0), operationsSources), level, yield) {
+			return
+		}
+
+		for _, check := range config.Checks {
+			if check == nil {
+				continue
+			}
+			if !yieldChanges(config, check(diffReport, operationsSources, config), level, yield) {
+				return
+			}
 		}
-		errs := check(diffReport, operationsSources, config)
-		result = append(result, errs...)
 	}
+}
 
-	filteredResult := make(Changes, 0)
-	for _, change := range result {
+// yieldChanges yields the sorted changes with level equal or higher than the given level, it returns false if the iteration was stopped
+func yieldChanges(config *Config, changes Changes, level Level, yield func(Change) bool) bool {
+	filteredChanges := make(Changes, 0, len(changes))
+	for _, change := range changes {
 		if config.getLogLevel(change.GetId()) >= level {
-			filteredResult = append(filteredResult, change)
+			filteredChanges = append(filteredChanges, change)
 		}
 	}
 
-	sort.Sort(filteredResult)
-	return filteredResult
+	sort.Sort(filteredChanges)
+	for _, change := range filteredChanges {
+		if !yield(change) {
+			return false
+		}
+	}
+
+	return true
 }
 
 func removeDraftAndAlphaOperationsDiffs(config *Config, diffReport *diff.Diff, result Changes, operationsSources *diff.OperationsSourcesMap) Changes {
@@ -58,7 +85,7 @@ func removeDraftAndAlphaOperationsDiffs(config *Config, diffReport *diff.Diff, r
 		ignore := true
 		pathDiff := diffReport.PathsDiff
 		for operation, operationItem := range pathDiff.Base.Value(path).Operations() {
-			baseStability, err := getStabilityLevel(pathDiff.Base.Value(path).GetOperation(operation).Extensions)
+			baseStability, err := getOperationStabilityLevel(config, pathDiff.Base.Value(path).GetOperation(operation), path)
 			if err != nil {
 				result = append(result, getAPIInvalidStabilityLevel(config, operationItem, operationsSources, operation, path, err))
 				continue
@@ -84,7 +111,7 @@ func removeDraftAndAlphaOperationsDiffs(config *Config, diffReport *diff.Diff, r
 		iOperation := 0
 		for _, operation := range pathDiff.OperationsDiff.Deleted {
 			operationItem := pathDiff.Base.GetOperation(operation)
-			baseStability, err := getStabilityLevel(operationItem.Extensions)
+			baseStability, err := getOperationStabilityLevel(config, operationItem, path)
 			if err != nil {
 				result = append(result, getAPIInvalidStabilityLevel(config, operationItem, operationsSources, operation, path, err))
 				continue
@@ -98,12 +125,12 @@ func removeDraftAndAlphaOperationsDiffs(config *Config, diffReport *diff.Diff, r
 
 		// remove draft and alpha operations diffs modified
 		for operation, operationItem := range pathDiff.OperationsDiff.Modified {
-			baseStability, err := getStabilityLevel(pathDiff.Base.GetOperation(operation).Extensions)
+			baseStability, err := getOperationStabilityLevel(config, pathDiff.Base.GetOperation(operation), path)
 			if err != nil {
 				result = append(result, getAPIInvalidStabilityLevel(config, operationItem.Base, operationsSources, operation, path, err))
 				continue
 			}
-			revisionStability, err := getStabilityLevel(pathDiff.Revision.GetOperation(operation).Extensions)
+			revisionStability, err := getOperationStabilityLevel(config, pathDiff.Revision.GetOperation(operation), path)
 			if err != nil {
 				result = append(result, getAPIInvalidStabilityLevel(config, operationItem.Revision, operationsSources, operation, path, err))
 				continue
@@ -146,6 +173,13 @@ func getAPIInvalidStabilityLevel(config *Config, operation *openapi3.Operation,
 	)
 }
 
+func isValidStabilityLevel(stabilityLevel string) bool {
+	return stabilityLevel == STABILITY_DRAFT ||
+		stabilityLevel == STABILITY_ALPHA ||
+		stabilityLevel == STABILITY_BETA ||
+		stabilityLevel == STABILITY_STABLE
+}
+
 func getStabilityLevel(i map[string]interface{}) (string, error) {
 	if i == nil || i[diff.XStabilityLevelExtension] == nil {
 		return "", nil
@@ -164,10 +198,7 @@ func getStabilityLevel(i map[string]interface{}) (string, error) {
 		}
 	}
 
-	if stabilityLevel != STABILITY_DRAFT &&
-		stabilityLevel != STABILITY_ALPHA &&
-		stabilityLevel != STABILITY_BETA &&
-		stabilityLevel != STABILITY_STABLE {
+	if !isValidStabilityLevel(stabilityLevel) {
 		return "", fmt.Errorf("value is not one of %s, %s, %s or %s: %q", STABILITY_DRAFT, STABILITY_ALPHA, STABILITY_BETA, STABILITY_STABLE, stabilityLevel)
 	}
 
@@ -1,6 +1,8 @@
 package checker_test
 
 import (
+	"slices"
+	"sort"
 	"testing"
 
 	"github.com/oasdiff/oasdiff/checker"
@@ -95,3 +97,33 @@ func TestBreaking_InvalidNonJsonStabilityLevel(t *testing.T) {
 	require.Equal(t, "failed to parse stability level: 'x-stability-level isn't a string nor valid json'", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
 	require.Equal(t, "../data/deprecation/base-invalid-stability-2.yaml", errs[0].GetSource())
 }
+
+func TestCheckBackwardCompatibilityUntilLevelSeq(t *testing.T) {
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), l(t, 1), l(t, 3))
+	require.NoError(t, err)
+	expected := checker.CheckBackwardCompatibilityUntilLevel(allChecksConfig(), d, osm, checker.INFO)
+
+	d, osm, err = diff.GetWithOperationsSourcesMap(diff.NewConfig(), l(t, 1), l(t, 3))
+	require.NoError(t, err)
+	changes := checker.Changes(slices.Collect(checker.CheckBackwardCompatibilityUntilLevelSeq(allChecksConfig(), d, osm, checker.INFO)))
+	sort.Sort(changes)
+
+	require.NotEmpty(t, changes)
+	require.Equal(t, expected, changes)
+}
+
+func TestCheckBackwardCompatibilityUntilLevelSeq_Stop(t *testing.T) {
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), l(t, 1), l(t, 3))
+	require.NoError(t, err)
+
+	count := 0
+	for range checker.CheckBackwardCompatibilityUntilLevelSeq(allChecksConfig(), d, osm, checker.INFO) {
+		count++
+		break
+	}
+	require.Equal(t, 1, count)
+}
+
+func TestCheckBackwardCompatibilityUntilLevelSeq_NilDiff(t *testing.T) {
+	require.Empty(t, slices.Collect(checker.CheckBackwardCompatibilityUntilLevelSeq(allChecksConfig(), nil, nil, checker.INFO)))
+}
@@ -207,6 +207,56 @@ func processDeletedPropertiesDiff(propertyPath string, propertyName string, sche
 	}
 }
 
+// CheckRenamedPropertiesDiff calls the processor with the original and new names of each renamed property, see diff.Config.DetectPropertyRenames
+func CheckRenamedPropertiesDiff(schemaDiff *diff.SchemaDiff, processor func(propertyPath string, propertyName1 string, propertyName2 string, propertyParentDiff *diff.SchemaDiff)) {
+	if schemaDiff == nil {
+		return
+	}
+
+	processRenamedPropertiesDiff("", "", schemaDiff, processor)
+}
+
+func processRenamedPropertiesDiff(propertyPath string, propertyName string, schemaDiff *diff.SchemaDiff, processor func(propertyPath string, propertyName1 string, propertyName2 string, propertyParentDiff *diff.SchemaDiff)) {
+	if propertyName != "" {
+		if propertyPath == "" {
+			propertyPath = propertyName
+		} else {
+			propertyPath = propertyPath + "/" + propertyName
+		}
+	}
+
+	if schemaDiff.AllOfDiff != nil {
+		for _, v := range schemaDiff.AllOfDiff.Modified {
+			processRenamedPropertiesDiff(fmt.Sprintf("%s/allOf[%s]", propertyPath, v), "", v.Diff, processor)
+		}
+	}
+
+	if schemaDiff.AnyOfDiff != nil {
+		for _, v := range schemaDiff.AnyOfDiff.Modified {
+			processRenamedPropertiesDiff(fmt.Sprintf("%s/anyOf[%s]", propertyPath, v), "", v.Diff, processor)
+		}
+	}
+
+	if schemaDiff.OneOfDiff != nil {
+		for _, v := range schemaDiff.OneOfDiff.Modified {
+			processRenamedPropertiesDiff(fmt.Sprintf("%s/oneOf[%s]", propertyPath, v), "", v.Diff, processor)
+		}
+	}
+
+	if schemaDiff.ItemsDiff != nil {
+		processRenamedPropertiesDiff(fmt.Sprintf("%s/items", propertyPath), "", schemaDiff.ItemsDiff, processor)
+	}
+
+	if schemaDiff.PropertiesDiff != nil {
+		for v1, v2 := range schemaDiff.PropertiesDiff.Renamed {
+			processor(propertyPath, v1, v2, schemaDiff)
+		}
+		for i, v := range schemaDiff.PropertiesDiff.Modified {
+			processRenamedPropertiesDiff(propertyPath, i, v, processor)
+		}
+	}
+}
+
 func IsIncreased(from interface{}, to interface{}) bool {
 	fromUint64, ok := from.(uint64)
 	toUint64, okTo := to.(uint64)
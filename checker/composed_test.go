@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/diff"
 	"github.com/oasdiff/oasdiff/load"
+	"github.com/oasdiff/oasdiff/utils"
 	"github.com/stretchr/testify/require"
 )
 
@@ -73,3 +75,94 @@ func TestComposed_CompareMostRecent(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, diffReport)
 }
+
+func TestComposed_Components(t *testing.T) {
+	s1 := []*load.SpecInfo{
+		loadFrom(t, "../data/composed/components/base/", 1),
+		loadFrom(t, "../data/composed/components/base/", 2),
+	}
+
+	s2 := []*load.SpecInfo{
+		loadFrom(t, "../data/composed/components/revision/", 1),
+		loadFrom(t, "../data/composed/components/revision/", 2),
+	}
+
+	diffReport, _, err := diff.GetPathsDiff(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	require.Equal(t, utils.StringList{"LegacyGroup"}, diffReport.SchemasDiff.Deleted)
+	require.Equal(t, utils.StringList{"ApiKey"}, diffReport.SecuritySchemesDiff.Deleted)
+	require.Equal(t, utils.StringList{"https://users.example.com"}, diffReport.ServersDiff.Deleted)
+	require.Nil(t, diffReport.SecurityDiff)
+}
+
+// BC: removing a schema in composed mode is breaking (optional)
+func TestComposed_SchemaRemoved(t *testing.T) {
+	s1 := []*load.SpecInfo{
+		loadFrom(t, "../data/composed/components/base/", 1),
+		loadFrom(t, "../data/composed/components/base/", 2),
+	}
+
+	s2 := []*load.SpecInfo{
+		loadFrom(t, "../data/composed/components/revision/", 1),
+		loadFrom(t, "../data/composed/components/revision/", 2),
+	}
+
+	diffReport, operationsSources, err := diff.GetPathsDiff(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+
+	errs := checker.CheckBackwardCompatibility(withOptionalCheck(t, allChecksConfig(), checker.APISchemasRemovedId), diffReport, operationsSources)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.APISchemasRemovedId, errs[0].GetId())
+
+	errs = checker.CheckBackwardCompatibilityUntilLevel(allChecksConfig(), diffReport, operationsSources, checker.INFO)
+	require.Contains(t, getIds(errs), checker.APIComponentsSecurityRemovedId)
+}
+
+func TestComposed_ConflictingComponents(t *testing.T) {
+	s1 := []*load.SpecInfo{
+		loadFrom(t, "../data/composed/components/conflict/", 1),
+		loadFrom(t, "../data/composed/components/conflict/", 2),
+	}
+
+	d, osm, err := diff.GetPathsDiff(diff.NewConfig(), s1, s1)
+	require.NoError(t, err)
+	require.Equal(t, diff.ComponentConflicts{
+		{
+			Component: "schemas",
+			Name:      "Group",
+			Source:    "../data/composed/components/conflict/spec1.yaml",
+			Ignored:   "../data/composed/components/conflict/spec2.yaml",
+		},
+	}, d.ComponentConflicts.Revision)
+
+	errs := checker.CheckBackwardCompatibility(allChecksConfig(), d, osm)
+	require.Len(t, errs, 2)
+	require.Equal(t, checker.APIComponentConflictId, errs[0].GetId())
+	require.Equal(t, checker.WARN, errs[0].GetLevel())
+	require.Equal(t, "conflicting definitions of 'components/schemas/Group' found in '../data/composed/components/conflict/spec1.yaml' and '../data/composed/components/conflict/spec2.yaml', using the first one", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+func TestComposed_ConflictingComponentsPathsDiff(t *testing.T) {
+	s1 := []*load.SpecInfo{
+		loadFrom(t, "../data/composed/components/error/base/", 1),
+		loadFrom(t, "../data/composed/components/error/base/", 2),
+	}
+
+	s2 := []*load.SpecInfo{
+		loadFrom(t, "../data/composed/components/error/revision/", 1),
+		loadFrom(t, "../data/composed/components/error/revision/", 2),
+	}
+
+	d, osm, err := diff.GetPathsDiff(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	require.Len(t, d.ComponentConflicts.Base, 1)
+	require.Len(t, d.ComponentConflicts.Revision, 1)
+	require.Equal(t, "Error", d.ComponentConflicts.Base[0].Name)
+
+	errs := checker.CheckBackwardCompatibility(allChecksConfig(), d, osm)
+	ids := []string{}
+	for _, e := range errs {
+		ids = append(ids, e.GetId())
+	}
+	require.ElementsMatch(t, []string{checker.APIRemovedWithoutDeprecationId, checker.APIComponentConflictId, checker.APIComponentConflictId}, ids)
+}
@@ -1,6 +1,11 @@
 package checker
 
-import "log"
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
 
 type Config struct {
 	Checks              BackwardCompatibilityChecks
@@ -8,6 +13,7 @@ type Config struct {
 	MinSunsetStableDays uint
 	LogLevels           map[string]Level
 	Attributes          []string
+	StabilityPolicy     *StabilityPolicy
 }
 
 const (
@@ -26,24 +32,35 @@ func NewConfig(checks BackwardCompatibilityChecks) *Config {
 }
 
 // WithOptionalCheck adds a check to the list of optional checks.
-func (config *Config) WithOptionalCheck(id string) *Config {
+// It returns an error if the check id is invalid
+func (config *Config) WithOptionalCheck(id string) (*Config, error) {
 	return config.WithOptionalChecks([]string{id})
 }
 
 // WithOptionalChecks overrides the log level of the given checks to ERR so they will appear in `oasdiff breaking`
-func (config *Config) WithOptionalChecks(ids []string) *Config {
+// It returns an error if any of the check ids is invalid
+func (config *Config) WithOptionalChecks(ids []string) (*Config, error) {
+	var errs []error
 	for _, id := range ids {
-		config.setLogLevel(id, ERR)
+		errs = append(errs, config.setLogLevel(id, ERR))
 	}
-	return config
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return config, nil
 }
 
-func (config *Config) WithSeverityLevels(severityLevels map[string]Level) *Config {
-	for id, level := range severityLevels {
-		config.setLogLevel(id, level)
+// WithSeverityLevels overrides the log level of the given checks
+// It returns an error if any of the check ids is invalid
+func (config *Config) WithSeverityLevels(severityLevels map[string]Level) (*Config, error) {
+	var errs []error
+	for _, id := range slices.Sorted(maps.Keys(severityLevels)) {
+		errs = append(errs, config.setLogLevel(id, severityLevels[id]))
 	}
-
-	return config
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return config, nil
 }
 
 // WithDeprecation sets the number of days before sunset for deprecation warnings.
@@ -53,6 +70,19 @@ func (config *Config) WithDeprecation(deprecationDaysBeta uint, deprecationDaysS
 	return config
 }
 
+// WithStabilityPolicy sets a policy for operations without an explicit stability level and overrides deprecation days for matching operations
+// It returns an error if the policy is invalid
+func (config *Config) WithStabilityPolicy(policy *StabilityPolicy) (*Config, error) {
+	if policy != nil {
+		if err := policy.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid stability policy: %w", err)
+		}
+	}
+
+	config.StabilityPolicy = policy
+	return config, nil
+}
+
 // WithSingleCheck sets a single check to be used.
 func (config *Config) WithSingleCheck(check BackwardCompatibilityCheck) *Config {
 	return config.WithChecks(BackwardCompatibilityChecks{check})
@@ -70,21 +100,22 @@ func (config *Config) WithAttributes(attributes []string) *Config {
 	return config
 }
 
+// getLogLevel returns the log level of the given check
+// Changes with an id that has no rule, for example, changes of custom checks, get the ERR level
 func (config *Config) getLogLevel(checkId string) Level {
 	level, ok := config.LogLevels[checkId]
-
 	if !ok {
-		log.Fatal("failed to get log level with invalid check id: ", checkId)
+		return ERR
 	}
 
 	return level
-
 }
 
-func (config *Config) setLogLevel(checkId string, level Level) {
+func (config *Config) setLogLevel(checkId string, level Level) error {
 	if _, ok := config.LogLevels[checkId]; !ok {
-		log.Fatal("failed to set log level with invalid check id: ", checkId)
+		return fmt.Errorf("invalid check id: %q", checkId)
 	}
 
 	config.LogLevels[checkId] = level
+	return nil
 }
@@ -8,8 +8,8 @@ import (
 )
 
 const (
-	numOfChecks = 93
-	numOfIds    = 273
+	numOfChecks = 103
+	numOfIds    = 301
 )
 
 func TestNewConfig(t *testing.T) {
@@ -30,12 +30,31 @@ func TestNewConfigWithDeprecation(t *testing.T) {
 
 func TestNewConfigWithOptionalCheck(t *testing.T) {
 	const id = checker.RequestPropertyDefaultValueChangedId
-	config := allChecksConfig().WithOptionalCheck(id)
+	config, err := allChecksConfig().WithOptionalCheck(id)
+	require.NoError(t, err)
 	require.Equal(t, checker.ERR, config.LogLevels[id])
 }
 
 func TestNewConfigWithSeverityLevels(t *testing.T) {
 	const id = checker.RequestPropertyDefaultValueChangedId
-	config := allChecksConfig().WithSeverityLevels(map[string]checker.Level{id: checker.ERR})
+	config, err := allChecksConfig().WithSeverityLevels(map[string]checker.Level{id: checker.ERR})
+	require.NoError(t, err)
 	require.Equal(t, checker.ERR, config.LogLevels[id])
 }
+
+func TestNewConfigWithOptionalCheckInvalid(t *testing.T) {
+	config := allChecksConfig()
+	_, err := config.WithOptionalCheck("invalid-id")
+	require.EqualError(t, err, `invalid check id: "invalid-id"`)
+	require.Len(t, config.LogLevels, numOfIds)
+}
+
+func TestNewConfigWithOptionalChecksInvalid(t *testing.T) {
+	_, err := allChecksConfig().WithOptionalChecks([]string{"invalid-id-1", checker.APISchemasRemovedId, "invalid-id-2"})
+	require.EqualError(t, err, "invalid check id: \"invalid-id-1\"\ninvalid check id: \"invalid-id-2\"")
+}
+
+func TestNewConfigWithSeverityLevelsInvalid(t *testing.T) {
+	_, err := allChecksConfig().WithSeverityLevels(map[string]checker.Level{"invalid-id": checker.ERR})
+	require.EqualError(t, err, `invalid check id: "invalid-id"`)
+}
@@ -0,0 +1,231 @@
+package checker
+
+import (
+	"sort"
+
+	"cloud.google.com/go/civil"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// DeprecationKind is the type of a deprecated resource
+type DeprecationKind string
+
+const (
+	DeprecationKindOperation DeprecationKind = "operation"
+	DeprecationKindParameter DeprecationKind = "parameter"
+	DeprecationKindProperty  DeprecationKind = "property"
+	DeprecationKindHeader    DeprecationKind = "header"
+)
+
+// DeprecationStatus describes the sunset of a deprecated resource
+type DeprecationStatus string
+
+const (
+	DeprecationStatusOK            DeprecationStatus = "ok"
+	DeprecationStatusSunsetMissing DeprecationStatus = "sunset-missing"
+	DeprecationStatusSunsetInvalid DeprecationStatus = "sunset-invalid"
+	DeprecationStatusPastDue       DeprecationStatus = "past-due"
+)
+
+// Deprecation is a resource marked as deprecated in a spec
+type Deprecation struct {
+	Kind      DeprecationKind
+	Method    string
+	Path      string
+	Name      string // name of the parameter, property or header, empty for operations
+	In        string // parameter location, 'request' or the response status for properties and headers
+	Stability string
+	Sunset    string
+	Status    DeprecationStatus
+	Error     string
+}
+
+// IsIssue returns true if the sunset is missing, invalid or past due
+func (deprecation Deprecation) IsIssue() bool {
+	return deprecation.Status != DeprecationStatusOK
+}
+
+type Deprecations []Deprecation
+
+// GetIssues returns the deprecations whose sunset is missing, invalid or past due
+func (deprecations Deprecations) GetIssues() Deprecations {
+	result := Deprecations{}
+	for _, deprecation := range deprecations {
+		if deprecation.IsIssue() {
+			result = append(result, deprecation)
+		}
+	}
+	return result
+}
+
+// GetDeprecations returns all operations, parameters, properties and response headers that are marked as deprecated in the spec
+// The sunset dates are compared with the given date to detect past due resources
+func GetDeprecations(spec *openapi3.T, date civil.Date) Deprecations {
+	result := Deprecations{}
+	if spec == nil || spec.Paths == nil {
+		return result
+	}
+
+	for path, pathItem := range spec.Paths.Map() {
+		for method, operation := range pathItem.Operations() {
+			c := deprecationCollector{
+				date:      date,
+				method:    method,
+				path:      path,
+				visited:   map[*openapi3.Schema]struct{}{},
+				result:    &result,
+				operation: operation,
+			}
+			c.collect(pathItem)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Path != result[j].Path {
+			return result[i].Path < result[j].Path
+		}
+		if result[i].Method != result[j].Method {
+			return result[i].Method < result[j].Method
+		}
+		if result[i].Kind != result[j].Kind {
+			return result[i].Kind < result[j].Kind
+		}
+		if result[i].In != result[j].In {
+			return result[i].In < result[j].In
+		}
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+type deprecationCollector struct {
+	date      civil.Date
+	method    string
+	path      string
+	operation *openapi3.Operation
+	stability string
+	visited   map[*openapi3.Schema]struct{}
+	result    *Deprecations
+}
+
+func (c *deprecationCollector) collect(pathItem *openapi3.PathItem) {
+	// an invalid stability level is reported by the breaking changes checks
+	c.stability, _ = getStabilityLevel(c.operation.Extensions)
+
+	if c.operation.Deprecated {
+		c.add(DeprecationKindOperation, "", "", c.operation.Extensions)
+	}
+
+	parameters := append(openapi3.Parameters{}, pathItem.Parameters...)
+	parameters = append(parameters, c.operation.Parameters...)
+	for _, paramRef := range parameters {
+		if paramRef == nil || paramRef.Value == nil {
+			continue
+		}
+		if paramRef.Value.Deprecated {
+			c.add(DeprecationKindParameter, paramRef.Value.Name, paramRef.Value.In, paramRef.Value.Extensions)
+		}
+	}
+
+	if c.operation.RequestBody != nil && c.operation.RequestBody.Value != nil {
+		c.collectContent(c.operation.RequestBody.Value.Content, "request")
+	}
+
+	if c.operation.Responses == nil {
+		return
+	}
+
+	for status, responseRef := range c.operation.Responses.Map() {
+		if responseRef == nil || responseRef.Value == nil {
+			continue
+		}
+		for name, headerRef := range responseRef.Value.Headers {
+			if headerRef == nil || headerRef.Value == nil {
+				continue
+			}
+			if headerRef.Value.Deprecated {
+				c.add(DeprecationKindHeader, name, status, headerRef.Value.Extensions)
+			}
+		}
+		c.collectContent(responseRef.Value.Content, status)
+	}
+}
+
+func (c *deprecationCollector) collectContent(content openapi3.Content, in string) {
+	for _, mediaType := range content {
+		if mediaType == nil || mediaType.Schema == nil {
+			continue
+		}
+		c.collectSchema(mediaType.Schema.Value, "", in)
+	}
+}
+
+func (c *deprecationCollector) collectSchema(schema *openapi3.Schema, propertyPath string, in string) {
+	if schema == nil {
+		return
+	}
+
+	// avoid infinite recursion in circular schemas, visited holds the schemas on the current path only so shared schemas are still reported in each location
+	if _, ok := c.visited[schema]; ok {
+		return
+	}
+	c.visited[schema] = struct{}{}
+	defer delete(c.visited, schema)
+
+	for name, propertyRef := range schema.Properties {
+		if propertyRef == nil || propertyRef.Value == nil {
+			continue
+		}
+		if propertyRef.Value.Deprecated {
+			c.add(DeprecationKindProperty, propertyFullName(propertyPath, name), in, propertyRef.Value.Extensions)
+		}
+		c.collectSchema(propertyRef.Value, propertyFullName(propertyPath, name), in)
+	}
+
+	if schema.Items != nil {
+		c.collectSchema(schema.Items.Value, propertyPath, in)
+	}
+
+	for _, schemas := range []openapi3.SchemaRefs{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for _, schemaRef := range schemas {
+			if schemaRef != nil {
+				c.collectSchema(schemaRef.Value, propertyPath, in)
+			}
+		}
+	}
+}
+
+func (c *deprecationCollector) add(kind DeprecationKind, name string, in string, extensions map[string]any) {
+	deprecation := Deprecation{
+		Kind:      kind,
+		Method:    c.method,
+		Path:      c.path,
+		Name:      name,
+		In:        in,
+		Stability: c.stability,
+		Status:    DeprecationStatusOK,
+	}
+
+	sunset, ok := getSunset(extensions)
+	if !ok {
+		deprecation.Status = DeprecationStatusSunsetMissing
+		*c.result = append(*c.result, deprecation)
+		return
+	}
+
+	date, err := getSunsetDate(sunset)
+	if err != nil {
+		deprecation.Status = DeprecationStatusSunsetInvalid
+		deprecation.Error = err.Error()
+		*c.result = append(*c.result, deprecation)
+		return
+	}
+
+	deprecation.Sunset = date.String()
+	if date.Before(c.date) {
+		deprecation.Status = DeprecationStatusPastDue
+	}
+
+	*c.result = append(*c.result, deprecation)
+}
@@ -0,0 +1,67 @@
+package checker_test
+
+import (
+	"testing"
+
+	"cloud.google.com/go/civil"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/stretchr/testify/require"
+)
+
+// circular schemas are traversed only once per location
+func TestGetDeprecations(t *testing.T) {
+	s, err := open(getDeprecationFile("deprecations.yaml"))
+	require.NoError(t, err)
+
+	deprecations := checker.GetDeprecations(s.Spec, civil.Date{Year: 2024, Month: 1, Day: 1})
+	require.Equal(t, checker.Deprecations{
+		{
+			Kind:      checker.DeprecationKindHeader,
+			Method:    "GET",
+			Path:      "/api/test",
+			Name:      "X-Rate-Limit",
+			In:        "200",
+			Stability: "beta",
+			Status:    checker.DeprecationStatusSunsetInvalid,
+			Error:     "sunset date doesn't conform with RFC3339: invalid-date",
+		},
+		{
+			Kind:      checker.DeprecationKindOperation,
+			Method:    "GET",
+			Path:      "/api/test",
+			Stability: "beta",
+			Sunset:    "2022-08-10",
+			Status:    checker.DeprecationStatusPastDue,
+		},
+		{
+			Kind:      checker.DeprecationKindParameter,
+			Method:    "GET",
+			Path:      "/api/test",
+			Name:      "id",
+			In:        "query",
+			Stability: "beta",
+			Status:    checker.DeprecationStatusSunsetMissing,
+		},
+		{
+			Kind:      checker.DeprecationKindProperty,
+			Method:    "GET",
+			Path:      "/api/test",
+			Name:      "name",
+			In:        "200",
+			Stability: "beta",
+			Sunset:    "9999-08-10",
+			Status:    checker.DeprecationStatusOK,
+		},
+		{
+			Kind:   checker.DeprecationKindProperty,
+			Method: "POST",
+			Path:   "/api/test",
+			Name:   "name",
+			In:     "request",
+			Sunset: "9999-08-10",
+			Status: checker.DeprecationStatusOK,
+		},
+	}, deprecations)
+
+	require.Len(t, deprecations.GetIssues(), 3)
+}
@@ -25,35 +25,54 @@ func ignoreLinePath(ignoreLine string) string {
 }
 
 func ProcessIgnoredBackwardCompatibilityErrors(level Level, errs Changes, ignoreFile string, l Localizer) (Changes, error) {
+	ignoreList, err := NewIgnoreList(level, ignoreFile)
+	if err != nil {
+		return nil, err
+	}
+
 	result := make(Changes, 0)
+	for _, err := range errs {
+		if !ignoreList.Match(err, l) {
+			result = append(result, err)
+		}
+	}
+	return result, nil
+}
 
+// IgnoreList is the content of an ignore file, it allows to ignore changes one at a time, for example, when streaming them
+type IgnoreList struct {
+	level Level
+	lines []string
+}
+
+// NewIgnoreList reads an ignore file for changes of the given level
+func NewIgnoreList(level Level, ignoreFile string) (*IgnoreList, error) {
 	ignore, err := os.Open(ignoreFile)
 	if err != nil {
 		return nil, err
 	}
 	defer ignore.Close()
-	ignoreScanner := bufio.NewScanner(ignore)
 
-	ignoredErrs := make([]bool, len(errs))
+	result := IgnoreList{level: level}
+	ignoreScanner := bufio.NewScanner(ignore)
 	for ignoreScanner.Scan() {
-		ignoreLine := strings.ToLower(ignoreScanner.Text())
-		ignorePath := ignoreLinePath(ignoreLine)
+		result.lines = append(result.lines, strings.ToLower(ignoreScanner.Text()))
+	}
 
-		for errIndex, err := range errs {
-			if err.GetLevel() != level {
-				continue
-			}
+	return &result, nil
+}
 
-			if err.MatchIgnore(ignorePath, ignoreLine, l) {
-				ignoredErrs[errIndex] = true
-			}
-		}
+// Match returns true if the change should be ignored
+func (ignoreList *IgnoreList) Match(change Change, l Localizer) bool {
+	if ignoreList == nil || change.GetLevel() != ignoreList.level {
+		return false
 	}
 
-	for errIndex, err := range errs {
-		if !ignoredErrs[errIndex] {
-			result = append(result, err)
+	for _, ignoreLine := range ignoreList.lines {
+		if change.MatchIgnore(ignoreLinePath(ignoreLine), ignoreLine, l) {
+			return true
 		}
 	}
-	return result, nil
+
+	return false
 }
@@ -59,10 +59,36 @@ func TestIgnoreComponent(t *testing.T) {
 
 	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
 	require.NoError(t, err)
-	errs := checker.CheckBackwardCompatibility(allChecksConfig().WithOptionalCheck(checker.APISchemasRemovedId), d, osm)
+	errs := checker.CheckBackwardCompatibility(withOptionalCheck(t, allChecksConfig(), checker.APISchemasRemovedId), d, osm)
 	require.Equal(t, 8, len(errs))
 
 	errs, err = checker.ProcessIgnoredBackwardCompatibilityErrors(checker.ERR, errs, "../data/ignore-err-example.txt", checker.NewDefaultLocalizer())
 	require.NoError(t, err)
 	require.Equal(t, 5, len(errs))
 }
+
+func TestIgnoreList(t *testing.T) {
+	s1 := l(t, 1)
+	s2 := l(t, 3)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(allChecksConfig(), d, osm)
+	require.Equal(t, 6, len(errs))
+
+	ignoreList, err := checker.NewIgnoreList(checker.ERR, "../data/ignore-err-example.txt")
+	require.NoError(t, err)
+
+	ignored := 0
+	for _, change := range errs {
+		if ignoreList.Match(change, checker.NewDefaultLocalizer()) {
+			ignored++
+		}
+	}
+	require.Equal(t, 1, ignored)
+}
+
+func TestIgnoreList_InvalidFile(t *testing.T) {
+	_, err := checker.NewIgnoreList(checker.ERR, "../data/no-such-file.txt")
+	require.Error(t, err)
+}
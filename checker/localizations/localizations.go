@@ -12,6 +12,8 @@ import (
 )
 
 var localizations = map[string]string{
+	"en.messages.api-component-conflict":                                     "conflicting definitions of %s found in %s and %s, using the first one",
+	"en.messages.api-component-conflict-description":                         "component defined differently in several specs of a composed collection",
 	"en.messages.api-deprecated-sunset-missing":                              "sunset date is missing for deprecated API",
 	"en.messages.api-deprecated-sunset-missing-description":                  "endpoint deprecated without sunset date",
 	"en.messages.api-deprecated-sunset-parse":                                "failed to parse sunset date: %v",
@@ -30,11 +32,17 @@ var localizations = map[string]string{
 	"en.messages.api-operation-id-added-description":                         "operation ID added to an endpoint",
 	"en.messages.api-operation-id-removed":                                   "api operation id %s removed and replaced with %s",
 	"en.messages.api-operation-id-removed-description":                       "operation ID deleted from an endpoint",
+	"en.messages.api-operation-method-changed":                               "api operation method changed from %s to %s",
+	"en.messages.api-operation-method-changed-description":                   "operation matched by operationId moved to a different method",
+	"en.messages.api-operation-path-changed":                                 "api operation path changed from %s to %s",
+	"en.messages.api-operation-path-changed-description":                     "operation matched by operationId moved to a different path",
 	"en.messages.api-path-removed-before-sunset":                             "api path removed before the sunset date %s",
 	"en.messages.api-path-removed-before-sunset-description":                 "path and endpoint deleted before sunset date",
 	"en.messages.api-path-removed-with-deprecation":                          "api path removed with deprecation",
 	"en.messages.api-path-removed-without-deprecation":                       "api path removed without deprecation",
 	"en.messages.api-path-removed-without-deprecation-description":           "path and endpoint deleted without deprecation",
+	"en.messages.api-path-renamed":                                           "api path renamed from %s to %s",
+	"en.messages.api-path-renamed-description":                               "path renamed with a path renames file or the x-oasdiff-renamed-from extension",
 	"en.messages.api-path-sunset-parse":                                      "failed to parse sunset date: %v",
 	"en.messages.api-path-sunset-parse-description":                          "path and endpoint deleted with invalid or missing sunset date",
 	"en.messages.api-removed-before-sunset":                                  "api removed before the sunset date %s",
@@ -42,8 +50,24 @@ var localizations = map[string]string{
 	"en.messages.api-removed-with-deprecation":                               "api removed with deprecation",
 	"en.messages.api-removed-without-deprecation":                            "api removed without deprecation",
 	"en.messages.api-removed-without-deprecation-description":                "endpoint deleted without deprecation",
+	"en.messages.api-schema-deprecated":                                      "schema %s was deprecated",
+	"en.messages.api-schema-deprecated-description":                          "schema deprecated",
+	"en.messages.api-schema-deprecated-sunset-missing":                       "schema %s was deprecated without sunset date",
+	"en.messages.api-schema-deprecated-sunset-missing-description":           "schema deprecated without sunset date",
+	"en.messages.api-schema-reactivated":                                     "schema %s was reactivated",
+	"en.messages.api-schema-reactivated-description":                         "schema reactivated (deprecation set to false)",
 	"en.messages.api-schema-removed":                                         "removed the schema %s",
+	"en.messages.api-schema-removed-before-sunset":                           "removed the schema %s before the sunset date %s",
+	"en.messages.api-schema-removed-before-sunset-description":               "schema deleted from components/schemas before sunset date",
 	"en.messages.api-schema-removed-description":                             "schema deleted from components/schemas",
+	"en.messages.api-schema-removed-with-deprecation":                        "removed the schema %s with deprecation",
+	"en.messages.api-schema-removed-with-deprecation-description":            "schema deleted from components/schemas after deprecation",
+	"en.messages.api-schema-renamed":                                         "renamed the schema %s to %s",
+	"en.messages.api-schema-renamed-description":                             "schema renamed in components/schemas",
+	"en.messages.api-schema-sunset-date-too-small":                           "schema %s sunset date %s is too small, must be at least %s days from now",
+	"en.messages.api-schema-sunset-date-too-small-description":               "deprecated schema sunset before min required deprecation days",
+	"en.messages.api-schema-sunset-parse":                                    "failed to parse sunset date for the schema %s: %v",
+	"en.messages.api-schema-sunset-parse-description":                        "schema deleted from components/schemas with invalid sunset date",
 	"en.messages.api-security-added":                                         "the endpoint scheme security %s was added to the API",
 	"en.messages.api-security-added-description":                             "security requirements added to endpoint",
 	"en.messages.api-security-component-added":                               "the component security scheme %s was added",
@@ -79,15 +103,15 @@ var localizations = map[string]string{
 	"en.messages.api-tag-added-description":                                  "endpoint tag added",
 	"en.messages.api-tag-removed":                                            "api tag %s removed",
 	"en.messages.api-tag-removed-description":                                "endpoint tag deleted",
-	"en.messages.at":                                                                  "at",
-	"en.messages.endpoint-added":                                                      "endpoint added",
-	"en.messages.endpoint-added-description":                                          "endpoint added",
-	"en.messages.endpoint-deprecated":                                                 "endpoint deprecated",
-	"en.messages.endpoint-deprecated-description":                                     "endpoint deprecated",
-	"en.messages.endpoint-reactivated":                                                "endpoint reactivated",
-	"en.messages.endpoint-reactivated-description":                                    "endpoint reactivated (deprecation set to false)",
-	"en.messages.in":                                                                  "in",
-	"en.messages.new-optional-request-default-parameter-to-existing-path":             "added the new optional %s request parameter %s to all path's operations",
+	"en.messages.at":                                                      "at",
+	"en.messages.endpoint-added":                                          "endpoint added",
+	"en.messages.endpoint-added-description":                              "endpoint added",
+	"en.messages.endpoint-deprecated":                                     "endpoint deprecated",
+	"en.messages.endpoint-deprecated-description":                         "endpoint deprecated",
+	"en.messages.endpoint-reactivated":                                    "endpoint reactivated",
+	"en.messages.endpoint-reactivated-description":                        "endpoint reactivated (deprecation set to false)",
+	"en.messages.in":                                                      "in",
+	"en.messages.new-optional-request-default-parameter-to-existing-path": "added the new optional %s request parameter %s to all path's operations",
 	"en.messages.new-optional-request-default-parameter-to-existing-path-description": "optional request parameter added at path level",
 	"en.messages.new-optional-request-parameter":                                      "added the new optional %s request parameter %s",
 	"en.messages.new-optional-request-parameter-description":                          "optional request parameter added to endpoint",
@@ -316,6 +340,10 @@ var localizations = map[string]string{
 	"en.messages.request-property-default-value-changed-description":                  "request property default value changed",
 	"en.messages.request-property-default-value-removed":                              "the %s request property default value %s was removed",
 	"en.messages.request-property-default-value-removed-description":                  "request property default value unset",
+	"en.messages.request-property-deprecated":                                         "request property %s was deprecated",
+	"en.messages.request-property-deprecated-description":                             "request property deprecated",
+	"en.messages.request-property-deprecated-sunset-missing":                          "request property %s was deprecated without sunset date",
+	"en.messages.request-property-deprecated-sunset-missing-description":              "request property deprecated without sunset date",
 	"en.messages.request-property-discriminator-added":                                "added discriminator to %s request property",
 	"en.messages.request-property-discriminator-added-description":                    "request property discriminator added",
 	"en.messages.request-property-discriminator-mapping-added":                        "added %s discriminator mapping keys to the %s request property",
@@ -375,8 +403,20 @@ var localizations = map[string]string{
 	"en.messages.request-property-pattern-generalized-description":                    "request property pattern generalized",
 	"en.messages.request-property-pattern-removed":                                    "removed the pattern %s from the request property %s",
 	"en.messages.request-property-pattern-removed-description":                        "request property pattern unset",
+	"en.messages.request-property-reactivated":                                        "request property %s was reactivated",
+	"en.messages.request-property-reactivated-description":                            "request property reactivated (deprecation set to false)",
 	"en.messages.request-property-removed":                                            "removed the request property %s",
+	"en.messages.request-property-removed-before-sunset":                              "removed the request property %s before the sunset date %s",
+	"en.messages.request-property-removed-before-sunset-description":                  "request property deleted before sunset date",
 	"en.messages.request-property-removed-description":                                "request property removed",
+	"en.messages.request-property-removed-with-deprecation":                           "removed the request property %s with deprecation",
+	"en.messages.request-property-removed-with-deprecation-description":               "request property deleted after deprecation",
+	"en.messages.request-property-renamed":                                            "renamed the request property %s to %s",
+	"en.messages.request-property-renamed-description":                                "request property renamed, detected by a removed and an added property with an equal schema",
+	"en.messages.request-property-sunset-date-too-small":                              "request property %s sunset date %s is too small, must be at least %s days from now",
+	"en.messages.request-property-sunset-date-too-small-description":                  "deprecated request property sunset before min required deprecation days",
+	"en.messages.request-property-sunset-parse":                                       "failed to parse sunset date for the request property %s: %v",
+	"en.messages.request-property-sunset-parse-description":                           "request property deleted with invalid sunset date",
 	"en.messages.request-property-type-changed":                                       "the %s request property type/format changed from %s/%s to %s/%s",
 	"en.messages.request-property-type-changed-description":                           "request property type changed",
 	"en.messages.request-property-type-generalized":                                   "the %s request property type/format was generalized from %s/%s to %s/%s",
@@ -497,6 +537,10 @@ var localizations = map[string]string{
 	"en.messages.response-property-default-value-changed-description":                 "response property default value changed",
 	"en.messages.response-property-default-value-removed":                             "the %s response's property default value %s was removed for the status %s",
 	"en.messages.response-property-default-value-removed-description":                 "response property default value unset",
+	"en.messages.response-property-deprecated":                                        "property %s in the response with the %s status was deprecated",
+	"en.messages.response-property-deprecated-description":                            "response property deprecated",
+	"en.messages.response-property-deprecated-sunset-missing":                         "property %s in the response with the %s status was deprecated without sunset date",
+	"en.messages.response-property-deprecated-sunset-missing-description":             "response property deprecated without sunset date",
 	"en.messages.response-property-discriminator-added":                               "added discriminator to %s response property for the response status %s",
 	"en.messages.response-property-discriminator-added-description":                   "response property discriminator added",
 	"en.messages.response-property-discriminator-mapping-added":                       "added %s discriminator mapping keys to the %s response property for the response status %s",
@@ -538,6 +582,18 @@ var localizations = map[string]string{
 	"en.messages.response-property-pattern-changed-description":                       "response property pattern changed",
 	"en.messages.response-property-pattern-removed":                                   "the %s response's property pattern %s was removed for the status %s",
 	"en.messages.response-property-pattern-removed-description":                       "response property pattern unset",
+	"en.messages.response-property-reactivated":                                       "property %s in the response with the %s status was reactivated",
+	"en.messages.response-property-reactivated-description":                           "response property reactivated (deprecation set to false)",
+	"en.messages.response-property-removed-before-sunset":                             "removed the property %s from the response with the %s status before the sunset date %s",
+	"en.messages.response-property-removed-before-sunset-description":                 "response property deleted before sunset date",
+	"en.messages.response-property-removed-with-deprecation":                          "removed the property %s from the response with the %s status with deprecation",
+	"en.messages.response-property-removed-with-deprecation-description":              "response property deleted after deprecation",
+	"en.messages.response-property-renamed":                                           "renamed the property %s to %s in the response with the %s status",
+	"en.messages.response-property-renamed-description":                               "response property renamed, detected by a removed and an added property with an equal schema",
+	"en.messages.response-property-sunset-date-too-small":                             "property %s in the response with the %s status sunset date %s is too small, must be at least %s days from now",
+	"en.messages.response-property-sunset-date-too-small-description":                 "deprecated response property sunset before min required deprecation days",
+	"en.messages.response-property-sunset-parse":                                      "failed to parse sunset date for the property %s in the response with the %s status: %v",
+	"en.messages.response-property-sunset-parse-description":                          "response property deleted with invalid sunset date",
 	"en.messages.response-property-type-changed":                                      "the %s response's property type/format changed from %s/%s to %s/%s for status %s",
 	"en.messages.response-property-type-changed-description":                          "response property type changed",
 	"en.messages.response-required-property-added":                                    "added the required property %s to the response with the %s status",
@@ -571,6 +627,7 @@ var localizations = map[string]string{
 	"en.messages.sunset-deleted-description":                                          "sunset deleted",
 	"en.messages.total-changes":                                                       "%d changes: %d %s, %d %s, %d %s\n",
 	"en.messages.total-errors":                                                        "%d breaking changes: %d %s, %d %s\n",
+	"ru.messages.api-component-conflict":                                              "найдены конфликтующие определения %s в %s и %s, используется первое",
 	"ru.messages.api-deprecated-sunset-missing":                                       "API устарел без даты прекращения действия",
 	"ru.messages.api-deprecated-sunset-parse":                                         "не удалось проанализировать дату заката: %v",
 	"ru.messages.api-global-security-added":                                           "схема безопасности %s была добавлена к API",
@@ -580,17 +637,21 @@ var localizations = map[string]string{
 	"ru.messages.api-invalid-stability-level":                                         "не удалось разобрать уровень стабильности: %v",
 	"ru.messages.api-operation-id-added":                                              "добавлен идентификатор операции API %s",
 	"ru.messages.api-operation-id-removed":                                            "Идентификатор операции API %s удален и заменен на %s",
+	"ru.messages.api-operation-method-changed":                                        "метод API операции изменён с %s на %s",
+	"ru.messages.api-operation-path-changed":                                          "путь API операции изменён с %s на %s",
 	"ru.messages.api-path-added":                                                      "API path добавлено",
 	"ru.messages.api-path-deprecated":                                                 "API path deprecated",
 	"ru.messages.api-path-reactivated":                                                "API path реактивирован",
 	"ru.messages.api-path-removed-before-sunset":                                      "API path удалён до даты sunset %s",
 	"ru.messages.api-path-removed-with-deprecation":                                   "API path удалён с процедурой deprecation",
 	"ru.messages.api-path-removed-without-deprecation":                                "API path удалён без процедуры deprecation",
+	"ru.messages.api-path-renamed":                                                    "API path переименован с %s на %s",
 	"ru.messages.api-path-sunset-parse":                                               "не удалось проанализировать дату заката: %v",
 	"ru.messages.api-removed-before-sunset":                                           "API удалёг до даты sunset %s",
 	"ru.messages.api-removed-with-deprecation":                                        "API удалён с процедурой deprecation",
 	"ru.messages.api-removed-without-deprecation":                                     "API удалён без deprecation",
 	"ru.messages.api-schema-removed":                                                  "удалена схема %s",
+	"ru.messages.api-schema-renamed":                                                  "схема %s переименована в %s",
 	"ru.messages.api-security-added":                                                  "схема безопасности точки доступа %s была добавлена к API",
 	"ru.messages.api-security-component-added":                                        "компонент схемы безопасности %s был добавлен",
 	"ru.messages.api-security-component-oauth-scope-added":                            "добавлено разрешение OAuth %s для компонента схемы безопасности %s",
@@ -759,6 +820,7 @@ var localizations = map[string]string{
 	"ru.messages.request-property-pattern-generalized":                                "изменил шаблон поля запроса %s со значения %s на более общее значение %s",
 	"ru.messages.request-property-pattern-removed":                                    "удалён pattern %s у поля запроса %s",
 	"ru.messages.request-property-removed":                                            "удалено поле запроса %s",
+	"ru.messages.request-property-renamed":                                            "поле запроса %s переименовано в %s",
 	"ru.messages.request-property-type-changed":                                       "у поля запроса %s изменился type/format с %s/%s на %s/%s",
 	"ru.messages.request-property-type-generalized":                                   "Тип/формат поля запроса %s был обобщен с %s/%s на %s/%s.",
 	"ru.messages.request-property-x-extensible-enum-value-removed":                    "удалено значение x-extensible-enum %s в поле запроса %s",
@@ -841,6 +903,7 @@ var localizations = map[string]string{
 	"ru.messages.response-property-pattern-added":                                     "у свойства %s для ответа со статусом %s добавлен паттерн %s",
 	"ru.messages.response-property-pattern-changed":                                   "у свойства %s для ответа со статусом %s изменился паттерн с %s на %s",
 	"ru.messages.response-property-pattern-removed":                                   "у свойства %s для ответа со статусом %s удален паттерн %s",
+	"ru.messages.response-property-renamed":                                           "поле %s переименовано в %s в ответе со статусом %s",
 	"ru.messages.response-property-type-changed":                                      "type/format свойства ответа %s изменен с %s/%s на %s/%s для статуса %s",
 	"ru.messages.response-required-property-added":                                    "добавил требуемое свойство %s в ответ со статусом %s",
 	"ru.messages.response-required-property-became-not-read-only":                     "обязательное свойство %s перестало быть только для чтения для ответа со статусом %s",
@@ -103,6 +103,16 @@ func GetAllRules() BackwardCompatibilityRules {
 		newBackwardCompatibilityRule(RequestParameterDeprecatedSunsetMissingId, ERR, RequestParameterDeprecationCheck, DirectionRequest, LocationParameters, ActionChange),
 		newBackwardCompatibilityRule(RequestParameterSunsetDateTooSmallId, ERR, RequestParameterDeprecationCheck, DirectionRequest, LocationParameters, ActionChange),
 		newBackwardCompatibilityRule(RequestParameterDeprecatedId, INFO, RequestParameterDeprecationCheck, DirectionRequest, LocationParameters, ActionChange),
+		// RequestPropertyDeprecationCheck
+		newBackwardCompatibilityRule(RequestPropertyReactivatedId, INFO, RequestPropertyDeprecationCheck, DirectionRequest, LocationProperties, ActionChange),
+		newBackwardCompatibilityRule(RequestPropertyDeprecatedSunsetMissingId, ERR, RequestPropertyDeprecationCheck, DirectionRequest, LocationProperties, ActionChange),
+		newBackwardCompatibilityRule(RequestPropertySunsetDateTooSmallId, ERR, RequestPropertyDeprecationCheck, DirectionRequest, LocationProperties, ActionChange),
+		newBackwardCompatibilityRule(RequestPropertyDeprecatedId, INFO, RequestPropertyDeprecationCheck, DirectionRequest, LocationProperties, ActionChange),
+		// ResponsePropertyDeprecationCheck
+		newBackwardCompatibilityRule(ResponsePropertyReactivatedId, INFO, ResponsePropertyDeprecationCheck, DirectionResponse, LocationProperties, ActionChange),
+		newBackwardCompatibilityRule(ResponsePropertyDeprecatedSunsetMissingId, ERR, ResponsePropertyDeprecationCheck, DirectionResponse, LocationProperties, ActionChange),
+		newBackwardCompatibilityRule(ResponsePropertySunsetDateTooSmallId, ERR, ResponsePropertyDeprecationCheck, DirectionResponse, LocationProperties, ActionChange),
+		newBackwardCompatibilityRule(ResponsePropertyDeprecatedId, INFO, ResponsePropertyDeprecationCheck, DirectionResponse, LocationProperties, ActionChange),
 		// APIRemovedCheck
 		newBackwardCompatibilityRule(APIPathRemovedWithoutDeprecationId, ERR, APIRemovedCheck, DirectionNone, LocationNone, ActionRemove),
 		newBackwardCompatibilityRule(APIPathRemovedWithDeprecationId, INFO, APIRemovedCheck, DirectionNone, LocationNone, ActionRemove),
@@ -111,6 +121,11 @@ func GetAllRules() BackwardCompatibilityRules {
 		newBackwardCompatibilityRule(APIRemovedWithoutDeprecationId, ERR, APIRemovedCheck, DirectionNone, LocationNone, ActionRemove),
 		newBackwardCompatibilityRule(APIRemovedWithDeprecationId, INFO, APIRemovedCheck, DirectionNone, LocationNone, ActionRemove),
 		newBackwardCompatibilityRule(APIRemovedBeforeSunsetId, ERR, APIRemovedCheck, DirectionNone, LocationNone, ActionRemove),
+		// APIPathRenamedCheck
+		newBackwardCompatibilityRule(APIPathRenamedId, ERR, APIPathRenamedCheck, DirectionNone, LocationNone, ActionChange),
+		// APIOperationMovedCheck
+		newBackwardCompatibilityRule(APIOperationPathChangedId, ERR, APIOperationMovedCheck, DirectionNone, LocationNone, ActionChange),
+		newBackwardCompatibilityRule(APIOperationMethodChangedId, ERR, APIOperationMovedCheck, DirectionNone, LocationNone, ActionChange),
 		// APISunsetChangedCheck
 		newBackwardCompatibilityRule(APISunsetDeletedId, ERR, APISunsetChangedCheck, DirectionNone, LocationNone, ActionRemove),
 		newBackwardCompatibilityRule(APISunsetDateChangedTooSmallId, ERR, APISunsetChangedCheck, DirectionNone, LocationNone, ActionChange),
@@ -296,8 +311,13 @@ func GetAllRules() BackwardCompatibilityRules {
 		newBackwardCompatibilityRule(RequestBodyTypeChangedId, ERR, RequestPropertyTypeChangedCheck, DirectionRequest, LocationBody, ActionChange),
 		newBackwardCompatibilityRule(RequestPropertyTypeGeneralizedId, INFO, RequestPropertyTypeChangedCheck, DirectionRequest, LocationProperties, ActionGeneralize),
 		newBackwardCompatibilityRule(RequestPropertyTypeChangedId, ERR, RequestPropertyTypeChangedCheck, DirectionRequest, LocationProperties, ActionChange),
+		// RequestPropertyRenamedCheck
+		newBackwardCompatibilityRule(RequestPropertyRenamedId, ERR, RequestPropertyRenamedCheck, DirectionRequest, LocationProperties, ActionChange),
 		// RequestPropertyUpdatedCheck
 		newBackwardCompatibilityRule(RequestPropertyRemovedId, WARN, RequestPropertyUpdatedCheck, DirectionRequest, LocationProperties, ActionRemove),
+		newBackwardCompatibilityRule(RequestPropertyRemovedWithDeprecationId, INFO, RequestPropertyUpdatedCheck, DirectionRequest, LocationProperties, ActionRemove),
+		newBackwardCompatibilityRule(RequestPropertySunsetParseId, ERR, RequestPropertyUpdatedCheck, DirectionRequest, LocationProperties, ActionChange),
+		newBackwardCompatibilityRule(RequestPropertyRemovedBeforeSunsetId, ERR, RequestPropertyUpdatedCheck, DirectionRequest, LocationProperties, ActionRemove),
 		newBackwardCompatibilityRule(NewRequiredRequestPropertyId, ERR, RequestPropertyUpdatedCheck, DirectionRequest, LocationProperties, ActionAdd),
 		newBackwardCompatibilityRule(NewRequiredRequestPropertyWithDefaultId, INFO, RequestPropertyUpdatedCheck, DirectionRequest, LocationProperties, ActionAdd),
 		newBackwardCompatibilityRule(NewOptionalRequestPropertyId, INFO, RequestPropertyUpdatedCheck, DirectionRequest, LocationProperties, ActionAdd),
@@ -333,6 +353,8 @@ func GetAllRules() BackwardCompatibilityRules {
 		// ResponseMediaTypeUpdatedCheck
 		newBackwardCompatibilityRule(ResponseMediaTypeRemovedId, ERR, ResponseMediaTypeUpdatedCheck, DirectionResponse, LocationBody, ActionRemove),
 		newBackwardCompatibilityRule(ResponseMediaTypeAddedId, INFO, ResponseMediaTypeUpdatedCheck, DirectionResponse, LocationBody, ActionAdd),
+		// ResponsePropertyRenamedCheck
+		newBackwardCompatibilityRule(ResponsePropertyRenamedId, ERR, ResponsePropertyRenamedCheck, DirectionResponse, LocationProperties, ActionChange),
 		// ResponseOptionalPropertyUpdatedCheck
 		newBackwardCompatibilityRule(ResponseOptionalPropertyRemovedId, WARN, ResponseOptionalPropertyUpdatedCheck, DirectionResponse, LocationProperties, ActionRemove),
 		newBackwardCompatibilityRule(ResponseOptionalWriteOnlyPropertyRemovedId, INFO, ResponseOptionalPropertyUpdatedCheck, DirectionResponse, LocationProperties, ActionRemove),
@@ -410,6 +432,10 @@ func GetAllRules() BackwardCompatibilityRules {
 		newBackwardCompatibilityRule(ResponseRequiredWriteOnlyPropertyRemovedId, INFO, ResponseRequiredPropertyUpdatedCheck, DirectionResponse, LocationProperties, ActionRemove),
 		newBackwardCompatibilityRule(ResponseRequiredPropertyAddedId, INFO, ResponseRequiredPropertyUpdatedCheck, DirectionResponse, LocationProperties, ActionAdd),
 		newBackwardCompatibilityRule(ResponseRequiredWriteOnlyPropertyAddedId, INFO, ResponseRequiredPropertyUpdatedCheck, DirectionResponse, LocationProperties, ActionAdd),
+		// ResponsePropertyRemovedWithDeprecationCheck
+		newBackwardCompatibilityRule(ResponsePropertyRemovedWithDeprecationId, INFO, ResponsePropertyRemovedWithDeprecationCheck, DirectionResponse, LocationProperties, ActionRemove),
+		newBackwardCompatibilityRule(ResponsePropertySunsetParseId, ERR, ResponsePropertyRemovedWithDeprecationCheck, DirectionResponse, LocationProperties, ActionChange),
+		newBackwardCompatibilityRule(ResponsePropertyRemovedBeforeSunsetId, ERR, ResponsePropertyRemovedWithDeprecationCheck, DirectionResponse, LocationProperties, ActionRemove),
 		// ResponseRequiredPropertyWriteOnlyReadOnlyCheck
 		newBackwardCompatibilityRule(ResponseRequiredPropertyBecameNonWriteOnlyId, WARN, ResponseRequiredPropertyWriteOnlyReadOnlyCheck, DirectionResponse, LocationProperties, ActionChange),
 		newBackwardCompatibilityRule(ResponseRequiredPropertyBecameWriteOnlyId, INFO, ResponseRequiredPropertyWriteOnlyReadOnlyCheck, DirectionResponse, LocationProperties, ActionChange),
@@ -429,6 +455,18 @@ func GetAllRules() BackwardCompatibilityRules {
 		newBackwardCompatibilityRule(APITagAddedId, INFO, APITagUpdatedCheck, DirectionNone, LocationNone, ActionAdd),
 		// APIComponentsSchemaRemovedCheck
 		newBackwardCompatibilityRule(APISchemasRemovedId, INFO, APIComponentsSchemaRemovedCheck, DirectionNone, LocationComponents, ActionRemove), // optional
+		newBackwardCompatibilityRule(APISchemaRemovedWithDeprecationId, INFO, APIComponentsSchemaRemovedCheck, DirectionNone, LocationComponents, ActionRemove),
+		newBackwardCompatibilityRule(APISchemaSunsetParseId, INFO, APIComponentsSchemaRemovedCheck, DirectionNone, LocationComponents, ActionChange),         // optional
+		newBackwardCompatibilityRule(APISchemaRemovedBeforeSunsetId, INFO, APIComponentsSchemaRemovedCheck, DirectionNone, LocationComponents, ActionRemove), // optional
+		// APIComponentsSchemaRenamedCheck
+		newBackwardCompatibilityRule(APISchemaRenamedId, INFO, APIComponentsSchemaRenamedCheck, DirectionNone, LocationComponents, ActionChange),
+		// APIComponentConflictCheck
+		newBackwardCompatibilityRule(APIComponentConflictId, WARN, APIComponentConflictCheck, DirectionNone, LocationComponents, ActionChange),
+		// APIComponentsSchemaDeprecationCheck
+		newBackwardCompatibilityRule(APISchemaReactivatedId, INFO, APIComponentsSchemaDeprecationCheck, DirectionNone, LocationComponents, ActionChange),
+		newBackwardCompatibilityRule(APISchemaDeprecatedSunsetMissingId, INFO, APIComponentsSchemaDeprecationCheck, DirectionNone, LocationComponents, ActionChange), // optional
+		newBackwardCompatibilityRule(APISchemaSunsetDateTooSmallId, INFO, APIComponentsSchemaDeprecationCheck, DirectionNone, LocationComponents, ActionChange),      // optional
+		newBackwardCompatibilityRule(APISchemaDeprecatedId, INFO, APIComponentsSchemaDeprecationCheck, DirectionNone, LocationComponents, ActionChange),
 		// ResponseParameterEnumValueRemovedCheck
 		newBackwardCompatibilityRule(ResponsePropertyEnumValueRemovedId, INFO, ResponseParameterEnumValueRemovedCheck, DirectionResponse, LocationProperties, ActionRemove), // optional
 		// ResponseMediaTypeEnumValueRemovedCheck
@@ -444,6 +482,10 @@ func GetOptionalRules() BackwardCompatibilityRules {
 		newBackwardCompatibilityRule(APIOperationIdRemovedId, INFO, APIOperationIdUpdatedCheck, DirectionNone, LocationNone, ActionRemove),
 		newBackwardCompatibilityRule(APITagRemovedId, INFO, APITagUpdatedCheck, DirectionNone, LocationNone, ActionRemove),
 		newBackwardCompatibilityRule(APISchemasRemovedId, INFO, APIComponentsSchemaRemovedCheck, DirectionNone, LocationComponents, ActionRemove),
+		newBackwardCompatibilityRule(APISchemaSunsetParseId, INFO, APIComponentsSchemaRemovedCheck, DirectionNone, LocationComponents, ActionChange),
+		newBackwardCompatibilityRule(APISchemaRemovedBeforeSunsetId, INFO, APIComponentsSchemaRemovedCheck, DirectionNone, LocationComponents, ActionRemove),
+		newBackwardCompatibilityRule(APISchemaDeprecatedSunsetMissingId, INFO, APIComponentsSchemaDeprecationCheck, DirectionNone, LocationComponents, ActionChange),
+		newBackwardCompatibilityRule(APISchemaSunsetDateTooSmallId, INFO, APIComponentsSchemaDeprecationCheck, DirectionNone, LocationComponents, ActionChange),
 		newBackwardCompatibilityRule(ResponsePropertyEnumValueRemovedId, INFO, ResponseParameterEnumValueRemovedCheck, DirectionResponse, LocationProperties, ActionRemove),
 		newBackwardCompatibilityRule(ResponseMediaTypeEnumValueRemovedId, INFO, ResponseMediaTypeEnumValueRemovedCheck, DirectionResponse, LocationBody, ActionRemove),
 		newBackwardCompatibilityRule(RequestBodyEnumValueRemovedId, INFO, RequestBodyEnumValueRemovedCheck, DirectionRequest, LocationBody, ActionRemove),
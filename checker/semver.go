@@ -0,0 +1,251 @@
+package checker
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+// VersionBump is the semantic version increment implied by a set of changes
+type VersionBump int8
+
+const (
+	BumpNone VersionBump = iota
+	BumpPatch
+	BumpMinor
+	BumpMajor
+)
+
+func (bump VersionBump) String() string {
+	switch bump {
+	case BumpPatch:
+		return "patch"
+	case BumpMinor:
+		return "minor"
+	case BumpMajor:
+		return "major"
+	default:
+		return "none"
+	}
+}
+
+// minorChangeIds are changes that aren't additions but still require a minor version bump according to semver
+var minorChangeIds = utils.StringList{
+	EndpointDeprecatedId,
+	RequestParameterDeprecatedId,
+	RequestPropertyDeprecatedId,
+	ResponsePropertyDeprecatedId,
+	APISchemaDeprecatedId,
+}.ToStringSet()
+
+// GetRequiredBump classifies the changes into the minimal semantic version increment:
+// - major: any change with level ERR
+// - minor: additions, such as new endpoints or new optional properties, and deprecations
+// - patch: any other change
+func GetRequiredBump(changes Changes) VersionBump {
+	actions := rulesToActions(GetAllRules())
+
+	result := BumpNone
+	for _, change := range changes {
+		bump := getChangeBump(change, actions)
+		if bump > result {
+			result = bump
+		}
+	}
+	return result
+}
+
+func getChangeBump(change Change, actions map[string]Action) VersionBump {
+	if change.GetLevel() == ERR {
+		return BumpMajor
+	}
+
+	if action, ok := actions[change.GetId()]; ok && action == ActionAdd {
+		return BumpMinor
+	}
+
+	if minorChangeIds.Contains(change.GetId()) {
+		return BumpMinor
+	}
+
+	return BumpPatch
+}
+
+// rulesToActions return a map of check IDs to actions
+func rulesToActions(rules BackwardCompatibilityRules) map[string]Action {
+	result := map[string]Action{}
+	for _, rule := range rules {
+		result[rule.Id] = rule.Action
+	}
+	return result
+}
+
+// Version is a semantic version as defined in https://semver.org
+type Version struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	PreRelease string
+}
+
+// ParseVersion parses a semantic version, an optional 'v' prefix and build metadata are allowed
+func ParseVersion(version string) (*Version, error) {
+	s := strings.TrimPrefix(strings.TrimSpace(version), "v")
+
+	// build metadata doesn't affect precedence
+	s, _, _ = strings.Cut(s, "+")
+	s, preRelease, _ := strings.Cut(s, "-")
+
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid semantic version %q: expected MAJOR.MINOR.PATCH", version)
+	}
+
+	numbers := make([]uint64, len(parts))
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid semantic version %q: %q is not a number", version, part)
+		}
+		numbers[i] = n
+	}
+
+	return &Version{
+		Major:      numbers[0],
+		Minor:      numbers[1],
+		Patch:      numbers[2],
+		PreRelease: preRelease,
+	}, nil
+}
+
+func (version *Version) String() string {
+	result := fmt.Sprintf("%d.%d.%d", version.Major, version.Minor, version.Patch)
+	if version.PreRelease != "" {
+		result += "-" + version.PreRelease
+	}
+	return result
+}
+
+// Bump returns the version incremented by the given bump
+// Before 1.0.0, a major bump increments the minor version and a minor bump increments the patch version, see GetDeclaredBump
+// A pre-release is bumped to its release if the release declares a large enough increment, for example, 1.2.0-rc.1 is bumped to 1.2.0 for minor and patch bumps
+func (version *Version) Bump(bump VersionBump) *Version {
+	if version.PreRelease != "" && bump != BumpNone && bump <= version.getReleaseBump() {
+		return &Version{Major: version.Major, Minor: version.Minor, Patch: version.Patch}
+	}
+
+	if version.Major == 0 && bump > BumpPatch {
+		bump--
+	}
+
+	switch bump {
+	case BumpMajor:
+		return &Version{Major: version.Major + 1}
+	case BumpMinor:
+		return &Version{Major: version.Major, Minor: version.Minor + 1}
+	case BumpPatch:
+		return &Version{Major: version.Major, Minor: version.Minor, Patch: version.Patch + 1}
+	default:
+		return &Version{Major: version.Major, Minor: version.Minor, Patch: version.Patch, PreRelease: version.PreRelease}
+	}
+}
+
+// GetDeclaredBump returns the increment between the base and revision versions
+// Before 1.0.0, semver allows anything to change at any time, so a minor increment is treated as major and a patch increment as minor
+// A pre-release, like 1.2.0-rc.1, already declares the increment of its release, so moving on to a later pre-release or to the release itself declares the same increment
+func GetDeclaredBump(base, revision *Version) (VersionBump, error) {
+	switch {
+	case revision.Major != base.Major:
+		if revision.Major < base.Major {
+			return BumpNone, fmt.Errorf("revision version %s is lower than base version %s", revision, base)
+		}
+		return BumpMajor, nil
+	case revision.Minor != base.Minor:
+		if revision.Minor < base.Minor {
+			return BumpNone, fmt.Errorf("revision version %s is lower than base version %s", revision, base)
+		}
+		if base.Major == 0 {
+			return BumpMajor, nil
+		}
+		return BumpMinor, nil
+	case revision.Patch != base.Patch:
+		if revision.Patch < base.Patch {
+			return BumpNone, fmt.Errorf("revision version %s is lower than base version %s", revision, base)
+		}
+		if base.Major == 0 {
+			return BumpMinor, nil
+		}
+		return BumpPatch, nil
+	}
+
+	switch comparePreReleases(base.PreRelease, revision.PreRelease) {
+	case 0:
+		return BumpNone, nil
+	case 1:
+		return BumpNone, fmt.Errorf("revision version %s is lower than base version %s", revision, base)
+	}
+
+	return base.getReleaseBump(), nil
+}
+
+// getReleaseBump returns the increment declared by a release, for example, 1.2.0 is a minor release
+func (version *Version) getReleaseBump() VersionBump {
+	var result VersionBump
+	switch {
+	case version.Patch != 0:
+		result = BumpPatch
+	case version.Minor != 0:
+		result = BumpMinor
+	default:
+		result = BumpMajor
+	}
+
+	if version.Major == 0 && result < BumpMajor {
+		result++
+	}
+	return result
+}
+
+// comparePreReleases compares the pre-releases of two versions with the same major, minor and patch according to semver precedence
+// It returns -1 if a is lower than b, 0 if they are equal and 1 if a is higher than b
+// A version without a pre-release is higher than any pre-release of the same version
+func comparePreReleases(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+
+	aIds := strings.Split(a, ".")
+	bIds := strings.Split(b, ".")
+	for i := 0; i < len(aIds) && i < len(bIds); i++ {
+		if result := comparePreReleaseIds(aIds[i], bIds[i]); result != 0 {
+			return result
+		}
+	}
+
+	return cmp.Compare(len(aIds), len(bIds))
+}
+
+// comparePreReleaseIds compares pre-release identifiers: numeric identifiers are compared numerically and are lower than alphanumeric ones, which are compared lexically
+func comparePreReleaseIds(a, b string) int {
+	aNum, aErr := strconv.ParseUint(a, 10, 64)
+	bNum, bErr := strconv.ParseUint(b, 10, 64)
+
+	switch {
+	case aErr == nil && bErr == nil:
+		return cmp.Compare(aNum, bNum)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
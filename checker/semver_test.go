@@ -0,0 +1,165 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRequiredBump_None(t *testing.T) {
+	require.Equal(t, checker.BumpNone, checker.GetRequiredBump(checker.Changes{}))
+}
+
+func TestGetRequiredBump_Major(t *testing.T) {
+	changes := checker.Changes{
+		checker.ApiChange{Id: checker.EndpointAddedId, Level: checker.INFO},
+		checker.ApiChange{Id: checker.APIPathRemovedWithoutDeprecationId, Level: checker.ERR},
+	}
+	require.Equal(t, checker.BumpMajor, checker.GetRequiredBump(changes))
+}
+
+func TestGetRequiredBump_Minor(t *testing.T) {
+	changes := checker.Changes{
+		checker.ApiChange{Id: checker.EndpointAddedId, Level: checker.INFO},
+		checker.ApiChange{Id: checker.RequestParameterMaxLengthIncreasedId, Level: checker.INFO},
+	}
+	require.Equal(t, checker.BumpMinor, checker.GetRequiredBump(changes))
+}
+
+func TestGetRequiredBump_Deprecation(t *testing.T) {
+	changes := checker.Changes{
+		checker.ApiChange{Id: checker.EndpointDeprecatedId, Level: checker.INFO},
+	}
+	require.Equal(t, checker.BumpMinor, checker.GetRequiredBump(changes))
+}
+
+func TestGetRequiredBump_Patch(t *testing.T) {
+	changes := checker.Changes{
+		checker.ApiChange{Id: checker.RequestParameterMaxLengthIncreasedId, Level: checker.INFO},
+	}
+	require.Equal(t, checker.BumpPatch, checker.GetRequiredBump(changes))
+}
+
+func TestParseVersion(t *testing.T) {
+	version, err := checker.ParseVersion("v1.2.3-beta.1+build.5")
+	require.NoError(t, err)
+	require.Equal(t, &checker.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "beta.1"}, version)
+	require.Equal(t, "1.2.3-beta.1", version.String())
+}
+
+func TestParseVersion_Invalid(t *testing.T) {
+	_, err := checker.ParseVersion("1.2")
+	require.EqualError(t, err, `invalid semantic version "1.2": expected MAJOR.MINOR.PATCH`)
+
+	_, err = checker.ParseVersion("1.x.3")
+	require.EqualError(t, err, `invalid semantic version "1.x.3": "x" is not a number`)
+}
+
+func TestVersionBump(t *testing.T) {
+	version := &checker.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc1"}
+	require.Equal(t, "2.0.0", version.Bump(checker.BumpMajor).String())
+	require.Equal(t, "1.3.0", version.Bump(checker.BumpMinor).String())
+	require.Equal(t, "1.2.3", version.Bump(checker.BumpPatch).String())
+	require.Equal(t, "1.2.3-rc1", version.Bump(checker.BumpNone).String())
+}
+
+func TestVersionBump_PreRelease(t *testing.T) {
+	version := &checker.Version{Major: 1, Minor: 2, PreRelease: "rc.1"}
+	require.Equal(t, "2.0.0", version.Bump(checker.BumpMajor).String())
+	require.Equal(t, "1.2.0", version.Bump(checker.BumpMinor).String())
+	require.Equal(t, "1.2.0", version.Bump(checker.BumpPatch).String())
+
+	version = &checker.Version{Major: 0, Minor: 3, PreRelease: "rc.1"}
+	require.Equal(t, "0.3.0", version.Bump(checker.BumpMajor).String())
+}
+
+func TestVersionBump_Initial(t *testing.T) {
+	version := &checker.Version{Major: 0, Minor: 2, Patch: 3}
+	require.Equal(t, "0.3.0", version.Bump(checker.BumpMajor).String())
+	require.Equal(t, "0.2.4", version.Bump(checker.BumpMinor).String())
+	require.Equal(t, "0.2.4", version.Bump(checker.BumpPatch).String())
+}
+
+func TestGetDeclaredBump(t *testing.T) {
+	base := &checker.Version{Major: 1, Minor: 2, Patch: 3}
+
+	bump, err := checker.GetDeclaredBump(base, &checker.Version{Major: 2})
+	require.NoError(t, err)
+	require.Equal(t, checker.BumpMajor, bump)
+
+	bump, err = checker.GetDeclaredBump(base, &checker.Version{Major: 1, Minor: 3})
+	require.NoError(t, err)
+	require.Equal(t, checker.BumpMinor, bump)
+
+	bump, err = checker.GetDeclaredBump(base, &checker.Version{Major: 1, Minor: 2, Patch: 4})
+	require.NoError(t, err)
+	require.Equal(t, checker.BumpPatch, bump)
+
+	bump, err = checker.GetDeclaredBump(base, base)
+	require.NoError(t, err)
+	require.Equal(t, checker.BumpNone, bump)
+}
+
+func TestGetDeclaredBump_Initial(t *testing.T) {
+	base := &checker.Version{Major: 0, Minor: 2, Patch: 3}
+
+	bump, err := checker.GetDeclaredBump(base, &checker.Version{Major: 0, Minor: 3})
+	require.NoError(t, err)
+	require.Equal(t, checker.BumpMajor, bump)
+
+	bump, err = checker.GetDeclaredBump(base, &checker.Version{Major: 0, Minor: 2, Patch: 4})
+	require.NoError(t, err)
+	require.Equal(t, checker.BumpMinor, bump)
+}
+
+func TestGetDeclaredBump_Lower(t *testing.T) {
+	_, err := checker.GetDeclaredBump(&checker.Version{Major: 1, Minor: 2, Patch: 3}, &checker.Version{Major: 1, Minor: 1, Patch: 5})
+	require.EqualError(t, err, "revision version 1.1.5 is lower than base version 1.2.3")
+}
+
+func TestGetDeclaredBump_PreRelease(t *testing.T) {
+	bump, err := checker.GetDeclaredBump(&checker.Version{Major: 1, Minor: 2, PreRelease: "rc.1"}, &checker.Version{Major: 1, Minor: 2})
+	require.NoError(t, err)
+	require.Equal(t, checker.BumpMinor, bump)
+
+	bump, err = checker.GetDeclaredBump(&checker.Version{Major: 2, PreRelease: "beta"}, &checker.Version{Major: 2, PreRelease: "rc.1"})
+	require.NoError(t, err)
+	require.Equal(t, checker.BumpMajor, bump)
+
+	bump, err = checker.GetDeclaredBump(&checker.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.2"}, &checker.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.10"})
+	require.NoError(t, err)
+	require.Equal(t, checker.BumpPatch, bump)
+
+	bump, err = checker.GetDeclaredBump(&checker.Version{Major: 1, Minor: 2, PreRelease: "rc.1"}, &checker.Version{Major: 1, Minor: 2, PreRelease: "rc.1"})
+	require.NoError(t, err)
+	require.Equal(t, checker.BumpNone, bump)
+
+	bump, err = checker.GetDeclaredBump(&checker.Version{Major: 1, Minor: 2, Patch: 3}, &checker.Version{Major: 1, Minor: 3, PreRelease: "rc.1"})
+	require.NoError(t, err)
+	require.Equal(t, checker.BumpMinor, bump)
+}
+
+func TestGetDeclaredBump_PreReleaseInitial(t *testing.T) {
+	bump, err := checker.GetDeclaredBump(&checker.Version{Major: 0, Minor: 3, PreRelease: "rc.1"}, &checker.Version{Major: 0, Minor: 3})
+	require.NoError(t, err)
+	require.Equal(t, checker.BumpMajor, bump)
+
+	bump, err = checker.GetDeclaredBump(&checker.Version{Major: 0, Minor: 3, Patch: 1, PreRelease: "rc.1"}, &checker.Version{Major: 0, Minor: 3, Patch: 1})
+	require.NoError(t, err)
+	require.Equal(t, checker.BumpMinor, bump)
+}
+
+func TestGetDeclaredBump_PreReleaseLower(t *testing.T) {
+	_, err := checker.GetDeclaredBump(&checker.Version{Major: 1, Minor: 2}, &checker.Version{Major: 1, Minor: 2, PreRelease: "rc.1"})
+	require.EqualError(t, err, "revision version 1.2.0-rc.1 is lower than base version 1.2.0")
+
+	_, err = checker.GetDeclaredBump(&checker.Version{Major: 1, Minor: 2, PreRelease: "rc.1"}, &checker.Version{Major: 1, Minor: 2, PreRelease: "beta.2"})
+	require.EqualError(t, err, "revision version 1.2.0-beta.2 is lower than base version 1.2.0-rc.1")
+
+	_, err = checker.GetDeclaredBump(&checker.Version{Major: 1, Minor: 2, PreRelease: "alpha.beta"}, &checker.Version{Major: 1, Minor: 2, PreRelease: "alpha.1"})
+	require.EqualError(t, err, "revision version 1.2.0-alpha.1 is lower than base version 1.2.0-alpha.beta")
+
+	_, err = checker.GetDeclaredBump(&checker.Version{Major: 1, Minor: 2, PreRelease: "alpha.1"}, &checker.Version{Major: 1, Minor: 2, PreRelease: "alpha"})
+	require.EqualError(t, err, "revision version 1.2.0-alpha is lower than base version 1.2.0-alpha.1")
+}
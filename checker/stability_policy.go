@@ -0,0 +1,167 @@
+package checker
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+// StabilityPolicy assigns stability levels and deprecation windows to operations
+// Operations are matched by path globs, tags or operationIds, the first matching rule applies
+// An explicit x-stability-level on the operation takes precedence over the policy
+type StabilityPolicy struct {
+	Rules []StabilityRule `yaml:"rules"`
+}
+
+// StabilityRule applies a stability level and/or a deprecation window to the operations that it matches
+// A rule matches an operation if it matches all of its non-empty selectors
+type StabilityRule struct {
+	Paths           []string `yaml:"paths"`
+	Tags            []string `yaml:"tags"`
+	OperationIds    []string `yaml:"operationIds"`
+	Stability       string   `yaml:"stability"`
+	DeprecationDays *uint    `yaml:"deprecation-days"`
+
+	pathRegexps []*regexp.Regexp
+}
+
+// ProcessStabilityPolicy reads a stability policy from a YAML or JSON file
+func ProcessStabilityPolicy(file string) (*StabilityPolicy, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return GetStabilityPolicy(f)
+}
+
+// GetStabilityPolicy reads a stability policy from a reader
+func GetStabilityPolicy(source io.Reader) (*StabilityPolicy, error) {
+	decoder := yaml.NewDecoder(source)
+	decoder.KnownFields(true)
+
+	var policy StabilityPolicy
+	if err := decoder.Decode(&policy); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to parse stability policy: %w", err)
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Validate checks the rules of the policy and prepares them for matching
+// It must be called on policies that weren't created by GetStabilityPolicy or ProcessStabilityPolicy
+func (policy *StabilityPolicy) Validate() error {
+	for i := range policy.Rules {
+		if err := policy.Rules[i].init(); err != nil {
+			return fmt.Errorf("invalid rule #%d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func (rule *StabilityRule) init() error {
+	if rule.Stability != "" && !isValidStabilityLevel(rule.Stability) {
+		return fmt.Errorf("stability is not one of %s, %s, %s or %s: %q", STABILITY_DRAFT, STABILITY_ALPHA, STABILITY_BETA, STABILITY_STABLE, rule.Stability)
+	}
+
+	if rule.Stability == "" && rule.DeprecationDays == nil {
+		return fmt.Errorf("rule must set stability or deprecation-days")
+	}
+
+	rule.pathRegexps = make([]*regexp.Regexp, len(rule.Paths))
+	for i, glob := range rule.Paths {
+		re, err := pathGlobToRegexp(glob)
+		if err != nil {
+			return fmt.Errorf("invalid path glob %q: %w", glob, err)
+		}
+		rule.pathRegexps[i] = re
+	}
+
+	return nil
+}
+
+// pathGlobToRegexp converts a path glob to a regular expression
+// '**' matches any number of path segments and '*' matches any characters within a single segment
+func pathGlobToRegexp(glob string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch {
+		case strings.HasPrefix(glob[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case glob[i] == '*':
+			sb.WriteString("[^/]*")
+		default:
+			sb.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	sb.WriteString("$")
+
+	return regexp.Compile(sb.String())
+}
+
+func (rule *StabilityRule) match(operation *openapi3.Operation, path string) bool {
+	if len(rule.pathRegexps) > 0 && !slices.ContainsFunc(rule.pathRegexps, func(re *regexp.Regexp) bool { return re.MatchString(path) }) {
+		return false
+	}
+
+	if len(rule.Tags) > 0 && !slices.ContainsFunc(operation.Tags, func(tag string) bool { return slices.Contains(rule.Tags, tag) }) {
+		return false
+	}
+
+	if len(rule.OperationIds) > 0 && !slices.Contains(rule.OperationIds, operation.OperationID) {
+		return false
+	}
+
+	return true
+}
+
+// getRule returns the first rule matching the operation or nil if none match
+func (policy *StabilityPolicy) getRule(operation *openapi3.Operation, path string) *StabilityRule {
+	if policy == nil || operation == nil {
+		return nil
+	}
+
+	for i := range policy.Rules {
+		if policy.Rules[i].match(operation, path) {
+			return &policy.Rules[i]
+		}
+	}
+
+	return nil
+}
+
+// getOperationStabilityLevel returns the stability level of an operation from x-stability-level or, if missing, from the stability policy
+func getOperationStabilityLevel(config *Config, operation *openapi3.Operation, path string) (string, error) {
+	stability, err := getStabilityLevel(operation.Extensions)
+	if err != nil || stability != "" {
+		return stability, err
+	}
+
+	if rule := config.StabilityPolicy.getRule(operation, path); rule != nil {
+		return rule.Stability, nil
+	}
+
+	return "", nil
+}
+
+// getOperationDeprecationDays returns the deprecation window of an operation from the stability policy or, if missing, according to its stability level
+func getOperationDeprecationDays(config *Config, operation *openapi3.Operation, path string, stability string) uint {
+	if rule := config.StabilityPolicy.getRule(operation, path); rule != nil && rule.DeprecationDays != nil {
+		return *rule.DeprecationDays
+	}
+
+	return getDeprecationDays(config, stability)
+}
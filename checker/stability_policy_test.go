@@ -0,0 +1,101 @@
+package checker_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/stretchr/testify/require"
+)
+
+func getStabilityPolicyFile(file string) string {
+	return "../data/stability-policy/" + file
+}
+
+func withStabilityPolicy(t *testing.T, config *checker.Config, file string) *checker.Config {
+	t.Helper()
+	policy, err := checker.ProcessStabilityPolicy(getStabilityPolicyFile(file))
+	require.NoError(t, err)
+	config, err = config.WithStabilityPolicy(policy)
+	require.NoError(t, err)
+	return config
+}
+
+// BC: removing an operation which is alpha according to the stability policy is not breaking
+func TestBreaking_StabilityPolicyAlphaRemoved(t *testing.T) {
+	s1, err := open(getDeprecationFile("base.yaml"))
+	require.NoError(t, err)
+
+	s2, err := open(getStabilityPolicyFile("get-removed.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+
+	errs := checker.CheckBackwardCompatibility(allChecksConfig(), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.APIRemovedWithoutDeprecationId, errs[0].GetId())
+
+	errs = checker.CheckBackwardCompatibility(withStabilityPolicy(t, allChecksConfig(), "alpha.yaml"), d, osm)
+	require.Empty(t, errs)
+}
+
+// BC: an explicit x-stability-level takes precedence over the stability policy
+func TestBreaking_StabilityPolicyExplicitLevel(t *testing.T) {
+	s1, err := open(getDeprecationFile("base-beta-stability.yaml"))
+	require.NoError(t, err)
+
+	s2, err := open(getStabilityPolicyFile("get-removed.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+
+	errs := checker.CheckBackwardCompatibility(withStabilityPolicy(t, allChecksConfig(), "alpha.yaml"), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.APIRemovedWithoutDeprecationId, errs[0].GetId())
+}
+
+// BC: deprecating an operation without a sunset date when the stability policy requires deprecation days is breaking
+func TestBreaking_StabilityPolicyDeprecationDays(t *testing.T) {
+	s1, err := open(getDeprecationFile("base.yaml"))
+	require.NoError(t, err)
+
+	s2, err := open(getDeprecationFile("deprecated-no-sunset.yaml"))
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+
+	c := withStabilityPolicy(t, singleCheckConfig(checker.APIDeprecationCheck), "deprecation-days.yaml")
+	errs := checker.CheckBackwardCompatibility(c, d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.APIDeprecatedSunsetMissingId, errs[0].GetId())
+}
+
+func TestStabilityPolicy_Invalid(t *testing.T) {
+	_, err := checker.ProcessStabilityPolicy(getStabilityPolicyFile("invalid.yaml"))
+	require.EqualError(t, err, `invalid rule #1: stability is not one of draft, alpha, beta or stable: "experimental"`)
+}
+
+func TestStabilityPolicy_MissingFile(t *testing.T) {
+	_, err := checker.ProcessStabilityPolicy(getStabilityPolicyFile("no-file.yaml"))
+	require.Error(t, err)
+}
+
+func TestStabilityPolicy_UnknownField(t *testing.T) {
+	_, err := checker.GetStabilityPolicy(strings.NewReader("rules:\n  - path: /api\n    stability: beta\n"))
+	require.Error(t, err)
+}
+
+func TestStabilityPolicy_EmptyRule(t *testing.T) {
+	_, err := checker.GetStabilityPolicy(strings.NewReader("rules:\n  - paths: [/api]\n"))
+	require.EqualError(t, err, "invalid rule #1: rule must set stability or deprecation-days")
+}
+
+func TestStabilityPolicy_InvalidConfig(t *testing.T) {
+	policy := &checker.StabilityPolicy{Rules: []checker.StabilityRule{{Stability: "invalid"}}}
+	_, err := allChecksConfig().WithStabilityPolicy(policy)
+	require.EqualError(t, err, `invalid stability policy: invalid rule #1: stability is not one of draft, alpha, beta or stable: "invalid"`)
+}
@@ -0,0 +1,55 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/utils/specgen"
+	"github.com/stretchr/testify/require"
+)
+
+func benchmarkDiff(b *testing.B, config *diff.Config, options specgen.Options) {
+	b.ReportAllocs()
+	for b.Loop() {
+		// diff filters the paths in place, so each iteration needs its own specs
+		b.StopTimer()
+		options.Revision = false
+		s1 := specgen.Generate(options)
+		options.Revision = true
+		s2 := specgen.Generate(options)
+		b.StartTimer()
+
+		_, err := diff.Get(config, s1, s2)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkDiff(b *testing.B) {
+	benchmarkDiff(b, diff.NewConfig(), specgen.DefaultOptions())
+}
+
+func BenchmarkDiff_Parallelism4(b *testing.B) {
+	benchmarkDiff(b, getParallelismConfig(4), specgen.DefaultOptions())
+}
+
+func BenchmarkDiff_Parallelism16(b *testing.B) {
+	benchmarkDiff(b, getParallelismConfig(16), specgen.DefaultOptions())
+}
+
+func BenchmarkDiff_NoAllOf(b *testing.B) {
+	options := specgen.DefaultOptions()
+	options.AllOfDepth = 0
+	benchmarkDiff(b, diff.NewConfig(), options)
+}
+
+func BenchmarkDiff_NoCircularRefs(b *testing.B) {
+	options := specgen.DefaultOptions()
+	options.Circular = false
+	benchmarkDiff(b, diff.NewConfig(), options)
+}
+
+func BenchmarkDiff_ManyPaths(b *testing.B) {
+	options := specgen.DefaultOptions()
+	options.Paths = 5000
+	benchmarkDiff(b, diff.NewConfig(), options)
+}
@@ -0,0 +1,30 @@
+package diff
+
+// ComponentConflict describes a component that is defined differently in two specs of a composed collection
+// The first definition is used to calculate the diff and the other one is ignored
+type ComponentConflict struct {
+	Component string `json:"component" yaml:"component"` // the components section, e.g. "schemas"
+	Name      string `json:"name" yaml:"name"`
+	Source    string `json:"source" yaml:"source"`   // the spec whose definition was used
+	Ignored   string `json:"ignored" yaml:"ignored"` // the spec whose definition was ignored
+}
+
+// ComponentConflicts is a list of component conflicts
+type ComponentConflicts []ComponentConflict
+
+// ComponentConflictsDiff lists the component conflicts found in the base and revision collections in composed mode
+type ComponentConflictsDiff struct {
+	Base     ComponentConflicts `json:"base,omitempty" yaml:"base,omitempty"`
+	Revision ComponentConflicts `json:"revision,omitempty" yaml:"revision,omitempty"`
+}
+
+func getComponentConflictsDiff(conflicts1, conflicts2 ComponentConflicts) *ComponentConflictsDiff {
+	if len(conflicts1) == 0 && len(conflicts2) == 0 {
+		return nil
+	}
+
+	return &ComponentConflictsDiff{
+		Base:     conflicts1,
+		Revision: conflicts2,
+	}
+}
@@ -15,6 +15,11 @@ type Config struct {
 	PathStripPrefixRevision string
 	ExcludeElements         utils.StringSet
 	IncludePathParams       bool
+	PathRenames             PathRenames
+	MatchBy                 string
+	SchemaRenameSimilarity  float64
+	DetectPropertyRenames   bool
+	Parallelism             int
 }
 
 const (
@@ -26,6 +31,19 @@ const (
 	ExcludeExtensionsOption  = "extensions"
 )
 
+const (
+	MatchByPathOption        = "path"
+	MatchByOperationIdOption = "operation-id"
+)
+
+// GetMatchByOptions returns the ways in which operations in base and revision can be matched to each other
+func GetMatchByOptions() []string {
+	return []string{
+		MatchByPathOption,
+		MatchByOperationIdOption,
+	}
+}
+
 func GetExcludeDiffOptions() []string {
 	return []string{
 		ExcludeExamplesOption,
@@ -40,7 +58,9 @@ func GetExcludeDiffOptions() []string {
 // NewConfig returns a default configuration
 func NewConfig() *Config {
 	return &Config{
-		ExcludeElements: utils.StringSet{},
+		ExcludeElements:        utils.StringSet{},
+		SchemaRenameSimilarity: DefaultSchemaRenameSimilarity,
+		Parallelism:            DefaultParallelism,
 	}
 }
 
@@ -49,6 +69,11 @@ func (config *Config) WithExcludeElements(excludeElements []string) *Config {
 	return config
 }
 
+// IsMatchByOperationId indicates whether operations are matched by their operationId before falling back to matching by path and method
+func (config *Config) IsMatchByOperationId() bool {
+	return config.MatchBy == MatchByOperationIdOption
+}
+
 func (config *Config) IsExcludeExamples() bool {
 	return config.ExcludeElements.Contains(ExcludeExamplesOption)
 }
@@ -77,4 +102,5 @@ const (
 	SunsetExtension          = "x-sunset"
 	XStabilityLevelExtension = "x-stability-level"
 	XExtensibleEnumExtension = "x-extensible-enum"
+	RenamedFromExtension     = "x-oasdiff-renamed-from"
 )
@@ -28,6 +28,9 @@ type Diff struct {
 	TagsDiff         *TagsDiff                 `json:"tags,omitempty" yaml:"tags,omitempty"`
 	ExternalDocsDiff *ExternalDocsDiff         `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
 
+	// ComponentConflicts is only set in composed mode
+	ComponentConflicts *ComponentConflictsDiff `json:"componentConflicts,omitempty" yaml:"componentConflicts,omitempty"`
+
 	ComponentsDiff `json:"components,omitempty" yaml:"components,omitempty"`
 }
 
@@ -89,6 +92,8 @@ func GetWithOperationsSourcesMap(config *Config, s1, s2 *load.SpecInfo) (*Diff,
 /*
 GetPathsDiff calculates the diff between a pair of slice of OpenAPI objects.
 It is helpful when you want to find diff and check for breaking changes for API divided into multiple files.
+Components, security requirements, servers and tags of each collection are merged before comparison.
+If there are components of the same kind with the same name but different definitions in a collection, then function uses the first definition and lists the conflict in Diff.ComponentConflicts.
 If there are same paths in different OpenAPI objects, then function uses version of the path with the last x-since-date extension.
 The x-since-date extension should be set on path or operations level. Extension set on the operations level overrides the value set on path level.
 If such path doesn't have the x-since-date extension, its value is default "2000-01-01"
@@ -120,6 +125,24 @@ func GetPathsDiff(config *Config, s1, s2 []*load.SpecInfo) (*Diff, *OperationsSo
 		return nil, nil, err
 	}
 
+	merged1, err := mergeSpecs(s1)
+	if err != nil {
+		return nil, nil, err
+	}
+	merged2, err := mergeSpecs(s2)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result.SecurityDiff = getSecurityRequirementsDiff(&merged1.security, &merged2.security)
+	result.ServersDiff = getServersDiff(config, &merged1.servers, &merged2.servers)
+	result.TagsDiff = getTagsDiff(config, merged1.tags, merged2.tags)
+	result.ComponentConflicts = getComponentConflictsDiff(merged1.conflicts, merged2.conflicts)
+
+	if result.ComponentsDiff, err = getComponentsDiff(config, state, &merged1.components, &merged2.components); err != nil {
+		return nil, nil, err
+	}
+
 	if result.Empty() {
 		return nil, nil, nil
 	}
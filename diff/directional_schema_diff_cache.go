@@ -1,20 +1,34 @@
 package diff
 
-import "github.com/getkin/kin-openapi/openapi3"
+import (
+	"sync"
 
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// directionalSchemaDiffCache is safe for concurrent use, so that it can be shared by paths that are diffed in parallel.
+// Diffs of circular references depend on the schemas that the current state is visiting, so they are never cached (see getSchemaDiff):
+// a state can't get a cut made by another state, or a placeholder for a diff that another goroutine is still calculating.
+// A cached diff of a schema that only references itself is therefore the same in every state, whichever path visited it first.
+// Diffs of mutually recursive schemas are cut at the schema where the cycle was entered, so they depend on the visiting order,
+// in parallel as in sequential diffs, where properties are visited in map order.
 type directionalSchemaDiffCache struct {
+	mu            sync.RWMutex
 	requestCache  schemaDiffCache
 	responseCache schemaDiffCache
 }
 
-func newDirectionalSchemaDiffCache() directionalSchemaDiffCache {
-	return directionalSchemaDiffCache{
+func newDirectionalSchemaDiffCache() *directionalSchemaDiffCache {
+	return &directionalSchemaDiffCache{
 		requestCache:  schemaDiffCache{},
 		responseCache: schemaDiffCache{},
 	}
 }
 
-func (cache directionalSchemaDiffCache) get(d direction, schema1, schema2 *openapi3.SchemaRef) (*SchemaDiff, bool) {
+func (cache *directionalSchemaDiffCache) get(d direction, schema1, schema2 *openapi3.SchemaRef) (*SchemaDiff, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
 	if d == directionRequest {
 		diff, ok := cache.requestCache[schemaPair{schema1, schema2}]
 		return diff, ok
@@ -24,7 +38,10 @@ func (cache directionalSchemaDiffCache) get(d direction, schema1, schema2 *opena
 	return diff, ok
 }
 
-func (cache directionalSchemaDiffCache) add(d direction, schema1, schema2 *openapi3.SchemaRef, diff *SchemaDiff) {
+func (cache *directionalSchemaDiffCache) add(d direction, schema1, schema2 *openapi3.SchemaRef, diff *SchemaDiff) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
 	if d == directionRequest {
 		cache.requestCache[schemaPair{schema1, schema2}] = diff
 		return
@@ -0,0 +1,12 @@
+package diff
+
+// EndpointDiff describes the change of the path and method of an operation that was matched to its counterpart by operationId, see Config.MatchBy
+type EndpointDiff struct {
+	PathDiff   *ValueDiff `json:"path,omitempty" yaml:"path,omitempty"`
+	MethodDiff *ValueDiff `json:"method,omitempty" yaml:"method,omitempty"`
+}
+
+// Empty indicates whether a change was found in this element
+func (diff *EndpointDiff) Empty() bool {
+	return diff == nil || *diff == EndpointDiff{}
+}
@@ -1,6 +1,8 @@
 package diff
 
 import (
+	"sort"
+
 	"github.com/getkin/kin-openapi/openapi3"
 )
 
@@ -72,7 +74,12 @@ func getEndpointsDiffInternal(config *Config, state *state, paths1, paths2 *open
 	paths1Mod := rewritePrefix(paths1.Map(), config.PathStripPrefixBase, config.PathPrefixBase)
 	paths2Mod := rewritePrefix(paths2.Map(), config.PathStripPrefixRevision, config.PathPrefixRevision)
 
-	addedPaths, deletedPaths, otherPaths := getPathItemsDiff(config, paths1Mod, paths2Mod)
+	paths2Mod, moves := matchOperationIds(config, paths1Mod, paths2Mod)
+
+	addedPaths, deletedPaths, otherPaths, err := getPathItemsDiff(config, paths1Mod, paths2Mod, moves)
+	if err != nil {
+		return nil, err
+	}
 
 	for path, pathItem := range addedPaths.Map() {
 		for method := range pathItem.Operations() {
@@ -86,12 +93,19 @@ func getEndpointsDiffInternal(config *Config, state *state, paths1, paths2 *open
 		}
 	}
 
-	for path, pathItemPair := range otherPaths {
-		if err := result.addModifiedPaths(config, state, path, pathItemPair); err != nil {
-			return nil, err
-		}
+	pathDiffs, err := getPathDiffs(config, state, otherPaths)
+	if err != nil {
+		return nil, err
 	}
 
+	for path, pathDiff := range pathDiffs {
+		result.addModifiedPaths(path, pathDiff)
+	}
+
+	// sort the endpoints so that the output doesn't depend on map iteration order
+	sort.Sort(result.Added)
+	sort.Sort(result.Deleted)
+
 	return result, nil
 }
 
@@ -109,15 +123,10 @@ func (diff *EndpointsDiff) addDeletedPath(path string, method string) {
 	})
 }
 
-func (diff *EndpointsDiff) addModifiedPaths(config *Config, state *state, path string, pathItemPair *pathItemPair) error {
-
-	pathDiff, err := getPathDiff(config, state, pathItemPair)
-	if err != nil {
-		return err
-	}
+func (diff *EndpointsDiff) addModifiedPaths(path string, pathDiff *PathDiff) {
 
 	if pathDiff.Empty() || pathDiff.OperationsDiff.Empty() {
-		return nil
+		return
 	}
 
 	for _, method := range pathDiff.OperationsDiff.Added {
@@ -134,8 +143,6 @@ func (diff *EndpointsDiff) addModifiedPaths(config *Config, state *state, path s
 			Path:   path,
 		}] = methodDiff
 	}
-
-	return nil
 }
 
 func (diff *EndpointsDiff) getSummary() *SummaryDetails {
@@ -0,0 +1,171 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// mergedSpec holds the spec-level elements of a collection of specs, other than paths, which are merged by mergedPaths
+type mergedSpec struct {
+	components openapi3.Components
+	security   openapi3.SecurityRequirements
+	servers    openapi3.Servers
+	tags       openapi3.Tags
+	conflicts  ComponentConflicts
+}
+
+// mergeSpecs merges the components, security requirements, servers and tags of a collection of specs
+// Components with the same name should be identical in all specs, otherwise the first definition is used and the conflict is recorded
+// Security requirements, servers and tags are merged into a list without duplicates
+func mergeSpecs(specs []*load.SpecInfo) (*mergedSpec, error) {
+	result := mergedSpec{
+		components: openapi3.Components{
+			Schemas:         openapi3.Schemas{},
+			Parameters:      openapi3.ParametersMap{},
+			Headers:         openapi3.Headers{},
+			RequestBodies:   openapi3.RequestBodies{},
+			Responses:       openapi3.ResponseBodies{},
+			SecuritySchemes: openapi3.SecuritySchemes{},
+			Examples:        openapi3.Examples{},
+			Links:           openapi3.Links{},
+			Callbacks:       openapi3.Callbacks{},
+		},
+		security: openapi3.SecurityRequirements{},
+		servers:  openapi3.Servers{},
+		tags:     openapi3.Tags{},
+	}
+
+	sources := componentSources{}
+
+	for _, s := range specs {
+		if s.Spec.Components != nil {
+			if err := result.mergeComponents(sources, s.Spec.Components, s.Url); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, securityRequirement := range s.Spec.Security {
+			if !containsSecurityRequirement(result.security, securityRequirement) {
+				result.security = append(result.security, securityRequirement)
+			}
+		}
+
+		for _, server := range s.Spec.Servers {
+			if server != nil && !containsServer(result.servers, server) {
+				result.servers = append(result.servers, server)
+			}
+		}
+
+		for _, tag := range s.Spec.Tags {
+			if tag != nil && result.tags.Get(tag.Name) == nil {
+				result.tags = append(result.tags, tag)
+			}
+		}
+	}
+
+	return &result, nil
+}
+
+func (merged *mergedSpec) mergeComponents(sources componentSources, components *openapi3.Components, url string) error {
+	components1 := &merged.components
+
+	if err := mergeComponentMap(merged, sources, "schemas", components1.Schemas, components.Schemas, url); err != nil {
+		return err
+	}
+	if err := mergeComponentMap(merged, sources, "parameters", components1.Parameters, components.Parameters, url); err != nil {
+		return err
+	}
+	if err := mergeComponentMap(merged, sources, "headers", components1.Headers, components.Headers, url); err != nil {
+		return err
+	}
+	if err := mergeComponentMap(merged, sources, "requestBodies", components1.RequestBodies, components.RequestBodies, url); err != nil {
+		return err
+	}
+	if err := mergeComponentMap(merged, sources, "responses", components1.Responses, components.Responses, url); err != nil {
+		return err
+	}
+	if err := mergeComponentMap(merged, sources, "securitySchemes", components1.SecuritySchemes, components.SecuritySchemes, url); err != nil {
+		return err
+	}
+	if err := mergeComponentMap(merged, sources, "examples", components1.Examples, components.Examples, url); err != nil {
+		return err
+	}
+	if err := mergeComponentMap(merged, sources, "links", components1.Links, components.Links, url); err != nil {
+		return err
+	}
+	if err := mergeComponentMap(merged, sources, "callbacks", components1.Callbacks, components.Callbacks, url); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// componentSources maps each components section and name to the spec that it was taken from
+type componentSources map[string]string
+
+// mergeComponentMap adds the components of a spec to the merged components
+// a component that already exists is compared with the new one, if they differ the existing one is kept and a conflict is recorded
+func mergeComponentMap[V any, M ~map[string]V](merged *mergedSpec, sources componentSources, section string, result M, components M, url string) error {
+	for _, name := range slices.Sorted(maps.Keys(components)) {
+		component := components[name]
+		key := section + "/" + name
+
+		existing, ok := result[name]
+		if !ok {
+			result[name] = component
+			sources[key] = url
+			continue
+		}
+
+		equal, err := componentsEqual(existing, component)
+		if err != nil {
+			return fmt.Errorf("failed to compare components/%s/%s in %s and %s: %w", section, name, sources[key], url, err)
+		}
+		if !equal {
+			merged.conflicts = append(merged.conflicts, ComponentConflict{
+				Component: section,
+				Name:      name,
+				Source:    sources[key],
+				Ignored:   url,
+			})
+		}
+	}
+	return nil
+}
+
+// componentsEqual compares components by their JSON representation, references are compared by name without resolving them
+func componentsEqual(component1, component2 any) (bool, error) {
+	json1, err := json.Marshal(component1)
+	if err != nil {
+		return false, err
+	}
+	json2, err := json.Marshal(component2)
+	if err != nil {
+		return false, err
+	}
+	return string(json1) == string(json2), nil
+}
+
+func containsSecurityRequirement(securityRequirements openapi3.SecurityRequirements, securityRequirement openapi3.SecurityRequirement) bool {
+	for _, s := range securityRequirements {
+		if reflect.DeepEqual(s, securityRequirement) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsServer(servers openapi3.Servers, server *openapi3.Server) bool {
+	for _, s := range servers {
+		if s.URL == server.URL {
+			return true
+		}
+	}
+	return false
+}
@@ -6,6 +6,7 @@ import (
 
 // MethodDiff describes the changes between a pair of operation objects: https://swagger.io/specification/#operation-object
 type MethodDiff struct {
+	EndpointDiff     *EndpointDiff             `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
 	ExtensionsDiff   *ExtensionsDiff           `json:"extensions,omitempty" yaml:"extensions,omitempty"`
 	TagsDiff         *StringsDiff              `json:"tags,omitempty" yaml:"tags,omitempty"`
 	SummaryDiff      *ValueDiff                `json:"summary,omitempty" yaml:"summary,omitempty"`
@@ -36,9 +37,9 @@ func (methodDiff *MethodDiff) Empty() bool {
 	return *methodDiff == MethodDiff{Base: methodDiff.Base, Revision: methodDiff.Revision}
 }
 
-func getMethodDiff(config *Config, state *state, operation1, operation2 *openapi3.Operation, pathParamsMap PathParamsMap) (*MethodDiff, error) {
+func getMethodDiff(config *Config, state *state, operation1, operation2 *openapi3.Operation, pathParamsMap PathParamsMap, endpointDiff *EndpointDiff) (*MethodDiff, error) {
 
-	diff, err := getMethodDiffInternal(config, state, operation1, operation2, pathParamsMap)
+	diff, err := getMethodDiffInternal(config, state, operation1, operation2, pathParamsMap, endpointDiff)
 
 	if err != nil {
 		return nil, err
@@ -51,11 +52,15 @@ func getMethodDiff(config *Config, state *state, operation1, operation2 *openapi
 	return diff, nil
 }
 
-func getMethodDiffInternal(config *Config, state *state, operation1, operation2 *openapi3.Operation, pathParamsMap PathParamsMap) (*MethodDiff, error) {
+func getMethodDiffInternal(config *Config, state *state, operation1, operation2 *openapi3.Operation, pathParamsMap PathParamsMap, endpointDiff *EndpointDiff) (*MethodDiff, error) {
 
 	result := newMethodDiff()
 	var err error
 
+	if !endpointDiff.Empty() {
+		result.EndpointDiff = endpointDiff
+	}
+
 	result.ExtensionsDiff, err = getExtensionsDiff(config, operation1.Extensions, operation2.Extensions)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,210 @@
+package diff
+
+import (
+	"sort"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+// operationMove describes an operation in revision which was matched by its operationId to an operation in base at a different path or method
+type operationMove struct {
+	EndpointDiff  *EndpointDiff
+	PathParamsMap PathParamsMap
+}
+
+// operationMoves maps paths in base to the moves of their operations by method
+type operationMoves map[string]map[string]*operationMove
+
+type operationLocation struct {
+	path   string
+	method string
+}
+
+// getSlot returns a location which is identical for operations that are matched to each other when matching by path and method
+func getSlot(config *Config, path, method string) operationLocation {
+	if config.IncludePathParams {
+		return operationLocation{path: path, method: method}
+	}
+	normalizedPath, _, _ := utils.NormalizeTemplatedPath(path)
+	return operationLocation{path: normalizedPath, method: method}
+}
+
+/*
+matchOperationIds pairs operations in base and revision by their operationId, see Config.MatchBy
+revision operations that were matched to a base operation at a different path or method are moved to the path and method of the base operation,
+so that the regular matching by path and method compares them to each other.
+operations without an operationId, operationIds that aren't unique and operations whose base location is taken by another revision operation are matched by path and method.
+*/
+func matchOperationIds(config *Config, paths1, paths2 *openapi3.Paths) (*openapi3.Paths, operationMoves) {
+	if !config.IsMatchByOperationId() {
+		return paths2, nil
+	}
+
+	locations1 := getOperationLocations(paths1)
+	locations2 := getOperationLocations(paths2)
+
+	// candidate moves by operationId
+	moving := map[string]bool{}
+	for id, location2 := range locations2 {
+		location1, ok := locations1[id]
+		if !ok {
+			continue
+		}
+		if getSlot(config, location1.path, location1.method) != getSlot(config, location2.path, location2.method) {
+			moving[id] = true
+		}
+	}
+
+	// revision operations that stay in place keep their slots, moves into occupied slots fall back to matching by path
+	occupied := map[operationLocation]bool{}
+	for path, pathItem := range paths2.Map() {
+		for method, operation := range pathItem.Operations() {
+			if !moving[operation.OperationID] {
+				occupied[getSlot(config, path, method)] = true
+			}
+		}
+	}
+
+	sortedIds := make([]string, 0, len(moving))
+	for id := range moving {
+		sortedIds = append(sortedIds, id)
+	}
+	sort.Strings(sortedIds)
+
+	for changed := true; changed; {
+		changed = false
+		claimed := map[operationLocation]bool{}
+		for _, id := range sortedIds {
+			if !moving[id] {
+				continue
+			}
+			location1 := locations1[id]
+			target := getSlot(config, location1.path, location1.method)
+			if occupied[target] || claimed[target] {
+				moving[id] = false
+				location2 := locations2[id]
+				occupied[getSlot(config, location2.path, location2.method)] = true
+				changed = true
+				break
+			}
+			claimed[target] = true
+		}
+	}
+
+	return moveOperations(config, paths2, locations1, moving)
+}
+
+// getOperationLocations returns the locations of operations by operationId, omitting operations without an operationId and operationIds that aren't unique
+func getOperationLocations(paths *openapi3.Paths) map[string]operationLocation {
+	result := map[string]operationLocation{}
+	duplicates := utils.StringSet{}
+
+	for path, pathItem := range paths.Map() {
+		for method, operation := range pathItem.Operations() {
+			id := operation.OperationID
+			if id == "" {
+				continue
+			}
+			if _, ok := result[id]; ok {
+				duplicates.Add(id)
+				continue
+			}
+			result[id] = operationLocation{path: path, method: method}
+		}
+	}
+
+	for id := range duplicates {
+		delete(result, id)
+	}
+
+	return result
+}
+
+// moveOperations returns a copy of paths2 in which the moving operations are moved to the location of their matching operation in base
+func moveOperations(config *Config, paths2 *openapi3.Paths, locations1 map[string]operationLocation, moving map[string]bool) (*openapi3.Paths, operationMoves) {
+	result := openapi3.NewPathsWithCapacity(paths2.Len())
+	moves := operationMoves{}
+
+	// paths in revision by slot path, to move operations into existing paths which differ only by path param names
+	existingPaths := map[string]string{}
+	for _, path := range sortedPaths(paths2) {
+		existingPaths[getSlot(config, path, "").path] = path
+	}
+
+	// operations that stay in place
+	for path, pathItem := range paths2.Map() {
+		newPathItem := copyPathItemWithoutOperations(pathItem)
+		for method, operation := range pathItem.Operations() {
+			if !moving[operation.OperationID] {
+				newPathItem.SetOperation(method, operation)
+			}
+		}
+		if len(newPathItem.Operations()) > 0 || len(pathItem.Operations()) == 0 {
+			result.Set(path, newPathItem)
+		}
+	}
+
+	// moved operations, in a stable order so that new paths take their path level fields from the same operation each time
+	for _, path2 := range sortedPaths(paths2) {
+		pathItem2 := paths2.Value(path2)
+		for _, method2 := range sortedMethods(pathItem2) {
+			operation := pathItem2.GetOperation(method2)
+			if !moving[operation.OperationID] {
+				continue
+			}
+
+			location1 := locations1[operation.OperationID]
+			targetPath := location1.path
+			if existingPath, ok := existingPaths[getSlot(config, location1.path, "").path]; ok && result.Value(existingPath) != nil {
+				targetPath = existingPath
+			}
+
+			target := result.Value(targetPath)
+			if target == nil {
+				target = copyPathItemWithoutOperations(pathItem2)
+				result.Set(targetPath, target)
+			}
+			target.SetOperation(location1.method, operation)
+
+			if moves[location1.path] == nil {
+				moves[location1.path] = map[string]*operationMove{}
+			}
+			moves[location1.path][location1.method] = &operationMove{
+				EndpointDiff: &EndpointDiff{
+					PathDiff:   getValueDiff(location1.path, path2),
+					MethodDiff: getValueDiff(location1.method, method2),
+				},
+				PathParamsMap: getRenamedPathParamsMap(location1.path, path2),
+			}
+		}
+	}
+
+	return result, moves
+}
+
+func copyPathItemWithoutOperations(pathItem *openapi3.PathItem) *openapi3.PathItem {
+	result := copyPathItem(pathItem)
+	for method := range result.Operations() {
+		result.SetOperation(method, nil)
+	}
+	return result
+}
+
+func sortedPaths(paths *openapi3.Paths) []string {
+	result := make([]string, 0, paths.Len())
+	for path := range paths.Map() {
+		result = append(result, path)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func sortedMethods(pathItem *openapi3.PathItem) []string {
+	result := []string{}
+	for method := range pathItem.Operations() {
+		result = append(result, method)
+	}
+	sort.Strings(result)
+	return result
+}
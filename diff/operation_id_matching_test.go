@@ -0,0 +1,90 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func loadMatchByOperationId(t *testing.T, file string) *openapi3.T {
+	t.Helper()
+	spec, err := openapi3.NewLoader().LoadFromFile("../data/match-by-operation-id/" + file)
+	require.NoError(t, err)
+	return spec
+}
+
+func getMatchByOperationIdConfig() *diff.Config {
+	config := diff.NewConfig()
+	config.MatchBy = diff.MatchByOperationIdOption
+	return config
+}
+
+func TestMatchByOperationId(t *testing.T) {
+	d, err := diff.Get(getMatchByOperationIdConfig(), loadMatchByOperationId(t, "base.yaml"), loadMatchByOperationId(t, "revision.yaml"))
+	require.NoError(t, err)
+
+	require.Empty(t, d.PathsDiff.Added)
+	require.Empty(t, d.PathsDiff.Deleted)
+
+	require.Equal(t, &diff.EndpointDiff{
+		PathDiff:   &diff.ValueDiff{From: "/users", To: "/v2/accounts"},
+		MethodDiff: &diff.ValueDiff{From: "POST", To: "PUT"},
+	}, d.PathsDiff.Modified["/users"].OperationsDiff.Modified["POST"].EndpointDiff)
+
+	getUser := d.PathsDiff.Modified["/users/{id}"].OperationsDiff.Modified["GET"]
+	require.Equal(t, &diff.EndpointDiff{
+		PathDiff: &diff.ValueDiff{From: "/users/{id}", To: "/v2/accounts/{accountId}"},
+	}, getUser.EndpointDiff)
+	require.Equal(t, utils.StringList{"fields"}, getUser.ParametersDiff.Added["query"])
+	require.Contains(t, getUser.ParametersDiff.Modified["path"], "id")
+
+	require.Empty(t, d.EndpointsDiff.Added)
+	require.Empty(t, d.EndpointsDiff.Deleted)
+	require.ElementsMatch(t, diff.Endpoints{{Method: "POST", Path: "/users"}, {Method: "GET", Path: "/users/{id}"}}, d.EndpointsDiff.Modified.ToEndpoints())
+}
+
+func TestMatchByPath(t *testing.T) {
+	d, err := diff.Get(diff.NewConfig(), loadMatchByOperationId(t, "base.yaml"), loadMatchByOperationId(t, "revision.yaml"))
+	require.NoError(t, err)
+
+	require.ElementsMatch(t, utils.StringList{"/v2/accounts", "/v2/accounts/{accountId}"}, d.PathsDiff.Added)
+	require.ElementsMatch(t, utils.StringList{"/users"}, d.PathsDiff.Deleted)
+	require.Equal(t, utils.StringList{"GET"}, d.PathsDiff.Modified["/users/{id}"].OperationsDiff.Deleted)
+}
+
+func TestMatchByOperationId_OccupiedFallback(t *testing.T) {
+	s2 := loadMatchByOperationId(t, "revision.yaml")
+	s2.Paths.Value("/users/{id}").Get = &openapi3.Operation{OperationID: "getUserV1", Responses: openapi3.NewResponses()}
+
+	d, err := diff.Get(getMatchByOperationIdConfig(), loadMatchByOperationId(t, "base.yaml"), s2)
+	require.NoError(t, err)
+
+	require.Equal(t, utils.StringList{"/v2/accounts/{accountId}"}, d.PathsDiff.Added)
+	getUser := d.PathsDiff.Modified["/users/{id}"].OperationsDiff.Modified["GET"]
+	require.Nil(t, getUser.EndpointDiff)
+	require.Equal(t, &diff.ValueDiff{From: "getUser", To: "getUserV1"}, getUser.OperationIDDiff)
+}
+
+func TestMatchByOperationId_DuplicateIdFallback(t *testing.T) {
+	s2 := loadMatchByOperationId(t, "revision.yaml")
+	s2.Paths.Value("/health").Get.OperationID = "createUser"
+
+	d, err := diff.Get(getMatchByOperationIdConfig(), loadMatchByOperationId(t, "base.yaml"), s2)
+	require.NoError(t, err)
+
+	require.Equal(t, utils.StringList{"/v2/accounts"}, d.PathsDiff.Added)
+	require.Equal(t, utils.StringList{"/users"}, d.PathsDiff.Deleted)
+}
+
+func TestMatchByOperationId_IncludePathParams(t *testing.T) {
+	config := getMatchByOperationIdConfig()
+	config.IncludePathParams = true
+
+	d, err := diff.Get(config, loadMatchByOperationId(t, "base.yaml"), loadMatchByOperationId(t, "revision.yaml"))
+	require.NoError(t, err)
+	require.Empty(t, d.PathsDiff.Added)
+	require.Empty(t, d.PathsDiff.Deleted)
+}
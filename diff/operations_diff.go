@@ -74,7 +74,14 @@ func getOperationsDiffInternal(config *Config, state *state, pathItemPair *pathI
 	var err error
 
 	for _, op := range operations {
-		err = result.diffOperation(config, state, pathItemPair.PathItem1.GetOperation(op), pathItemPair.PathItem2.GetOperation(op), op, pathItemPair.PathParamsMap)
+		pathParamsMap := pathItemPair.PathParamsMap
+		var endpointDiff *EndpointDiff
+		if move := pathItemPair.OperationMoves[op]; move != nil {
+			pathParamsMap = move.PathParamsMap
+			endpointDiff = move.EndpointDiff
+		}
+
+		err = result.diffOperation(config, state, pathItemPair.PathItem1.GetOperation(op), pathItemPair.PathItem2.GetOperation(op), op, pathParamsMap, endpointDiff)
 		if err != nil {
 			return nil, err
 		}
@@ -83,7 +90,7 @@ func getOperationsDiffInternal(config *Config, state *state, pathItemPair *pathI
 	return result, nil
 }
 
-func (operationsDiff *OperationsDiff) diffOperation(config *Config, state *state, operation1, operation2 *openapi3.Operation, method string, pathParamsMap PathParamsMap) error {
+func (operationsDiff *OperationsDiff) diffOperation(config *Config, state *state, operation1, operation2 *openapi3.Operation, method string, pathParamsMap PathParamsMap, endpointDiff *EndpointDiff) error {
 	if operation1 == nil && operation2 == nil {
 		return nil
 	}
@@ -98,7 +105,7 @@ func (operationsDiff *OperationsDiff) diffOperation(config *Config, state *state
 		return nil
 	}
 
-	diff, err := getMethodDiff(config, state, operation1, operation2, pathParamsMap)
+	diff, err := getMethodDiff(config, state, operation1, operation2, pathParamsMap, endpointDiff)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,55 @@
+package diff
+
+import (
+	"sort"
+	"sync"
+)
+
+// DefaultParallelism diffs paths sequentially
+const DefaultParallelism = 1
+
+// pathDiffs is a map of paths to their diffs, empty diffs are nil
+type pathDiffs map[string]*PathDiff
+
+// getPathDiffs diffs the pairs of path items, up to config.Parallelism pairs at a time
+// if several pairs fail, the error of the first path in sorted order is returned, so that the result doesn't depend on scheduling
+func getPathDiffs(config *Config, state *state, pairs pathItemPairs) (pathDiffs, error) {
+
+	paths := make([]string, 0, len(pairs))
+	for path := range pairs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	diffs := make([]*PathDiff, len(paths))
+	errs := make([]error, len(paths))
+
+	if config.Parallelism <= 1 || len(paths) <= 1 {
+		for i, path := range paths {
+			diffs[i], errs[i] = getPathDiff(config, state, pairs[path])
+		}
+	} else {
+		semaphore := make(chan struct{}, config.Parallelism)
+		var wg sync.WaitGroup
+		for i, path := range paths {
+			wg.Add(1)
+			semaphore <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-semaphore }()
+				diffs[i], errs[i] = getPathDiff(config, state.fork(), pairs[path])
+			}()
+		}
+		wg.Wait()
+	}
+
+	result := pathDiffs{}
+	for i, path := range paths {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		result[path] = diffs[i]
+	}
+
+	return result, nil
+}
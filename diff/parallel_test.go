@@ -0,0 +1,63 @@
+package diff_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/report"
+	"github.com/oasdiff/oasdiff/utils/specgen"
+	"github.com/stretchr/testify/require"
+)
+
+func getParallelismConfig(parallelism int) *diff.Config {
+	config := diff.NewConfig()
+	config.Parallelism = parallelism
+	return config
+}
+
+func TestParallelism_SameAsSequential(t *testing.T) {
+	for _, pair := range [][2]int{{1, 3}, {1, 5}, {3, 1}, {2, 4}, {1, 2}} {
+		t.Run(fmt.Sprintf("%d-%d", pair[0], pair[1]), func(t *testing.T) {
+			requireSameDiff(t, d(t, getParallelismConfig(1), pair[0], pair[1]), d(t, getParallelismConfig(8), pair[0], pair[1]))
+		})
+	}
+}
+
+func TestParallelism_GeneratedSpec(t *testing.T) {
+	sequential, err := diff.Get(getParallelismConfig(1), specgen.Generate(getGeneratedSpecOptions(200, false)), specgen.Generate(getGeneratedSpecOptions(200, true)))
+	require.NoError(t, err)
+
+	parallel, err := diff.Get(getParallelismConfig(8), specgen.Generate(getGeneratedSpecOptions(200, false)), specgen.Generate(getGeneratedSpecOptions(200, true)))
+	require.NoError(t, err)
+
+	require.Len(t, parallel.PathsDiff.Modified, 180)
+	require.Len(t, parallel.EndpointsDiff.Added, 240)
+	requireSameDiff(t, sequential, parallel)
+}
+
+// requireSameDiff compares diffs by their summaries and reports because some lists in the diff are unordered
+func requireSameDiff(t *testing.T, expected, actual *diff.Diff) {
+	t.Helper()
+	require.Equal(t, expected.GetSummary(), actual.GetSummary())
+	require.Equal(t, report.GetTextReportAsString(expected), report.GetTextReportAsString(actual))
+}
+
+func TestParallelism_Error(t *testing.T) {
+	s1 := specgen.Generate(getGeneratedSpecOptions(20, false))
+	s2 := specgen.Generate(getGeneratedSpecOptions(20, false))
+	s1.Paths.Set("/invalid", nil)
+	s2.Paths.Set("/invalid", nil)
+
+	_, err := diff.Get(getParallelismConfig(8), s1, s2)
+	require.Error(t, err)
+}
+
+func getGeneratedSpecOptions(paths int, revision bool) specgen.Options {
+	options := specgen.DefaultOptions()
+	options.Paths = paths
+	// generated schemas only reference themselves, so their cached diffs don't depend on the order in which they are visited
+	options.Circular = true
+	options.Revision = revision
+	return options
+}
@@ -8,6 +8,7 @@ import (
 
 // PathDiff describes the changes between a pair of path item objects: https://swagger.io/specification/#path-item-object
 type PathDiff struct {
+	RenameDiff      *ValueDiff                `json:"rename,omitempty" yaml:"rename,omitempty"`
 	ExtensionsDiff  *ExtensionsDiff           `json:"extensions,omitempty" yaml:"extensions,omitempty"`
 	RefDiff         *ValueDiff                `json:"ref,omitempty" yaml:"ref,omitempty"`
 	SummaryDiff     *ValueDiff                `json:"summary,omitempty" yaml:"summary,omitempty"`
@@ -59,6 +60,7 @@ func getPathDiffInternal(config *Config, state *state, pathItemPair *pathItemPai
 		return nil, err
 	}
 
+	result.RenameDiff = pathItemPair.RenameDiff
 	result.RefDiff = getValueDiff(pathItem1.Ref, pathItem2.Ref)
 	result.SummaryDiff = getValueDiffConditional(config.IsExcludeSummary(), pathItem1.Summary, pathItem2.Summary)
 	result.DescriptionDiff = getValueDiffConditional(config.IsExcludeDescription(), pathItem1.Description, pathItem2.Description)
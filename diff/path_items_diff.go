@@ -1,51 +1,93 @@
 package diff
 
 import (
-	"strings"
+	"fmt"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oasdiff/oasdiff/utils"
 )
 
 type pathItemPair struct {
-	PathItem1     *openapi3.PathItem
-	PathItem2     *openapi3.PathItem
-	PathParamsMap PathParamsMap
+	PathItem1      *openapi3.PathItem
+	PathItem2      *openapi3.PathItem
+	PathParamsMap  PathParamsMap
+	RenameDiff     *ValueDiff                // the change of the path name if the path was renamed, see PathRenames
+	OperationMoves map[string]*operationMove // operations that were matched by operationId by method, see Config.MatchBy
 }
 
 type pathItemPairs map[string]*pathItemPair
 
-func getPathItemsDiff(config *Config, paths1, paths2 *openapi3.Paths) (openapi3.Paths, openapi3.Paths, pathItemPairs) {
+func getPathItemsDiff(config *Config, paths1, paths2 *openapi3.Paths, moves operationMoves) (openapi3.Paths, openapi3.Paths, pathItemPairs, error) {
 
 	added := openapi3.Paths{}
 	deleted := openapi3.Paths{}
 	other := pathItemPairs{}
 
-	for endpoint1, pathItem1 := range paths1.Map() {
-		if pathItem2, pathParamsMap, ok := findEndpoint(config, endpoint1, paths2); ok {
+	renames, err := getPathRenames(config, paths2.Map())
+	if err != nil {
+		return added, deleted, other, err
+	}
+
+	// renamed paths are paired with each other and excluded from the regular matching
+	renamedTo := map[string]struct{}{}
+	for path1, path2 := range renames {
+		pathItem1, pathItem2 := paths1.Value(path1), paths2.Value(path2)
+		if pathItem1 == nil || pathItem2 == nil {
+			continue
+		}
+		if _, ok := renamedTo[path2]; ok {
+			return added, deleted, other, fmt.Errorf("more than one path is renamed to %q", path2)
+		}
+		renamedTo[path2] = struct{}{}
+		other[path1] = &pathItemPair{
+			PathItem1:      pathItem1,
+			PathItem2:      pathItem2,
+			PathParamsMap:  getRenamedPathParamsMap(path1, path2),
+			RenameDiff:     getValueDiff(path1, path2),
+			OperationMoves: moves[path1],
+		}
+	}
+
+	unrenamed1 := openapi3.NewPaths()
+	for path1, pathItem1 := range paths1.Map() {
+		if _, ok := other[path1]; !ok {
+			unrenamed1.Set(path1, pathItem1)
+		}
+	}
+
+	unrenamed2 := openapi3.NewPaths()
+	for path2, pathItem2 := range paths2.Map() {
+		if _, ok := renamedTo[path2]; !ok {
+			unrenamed2.Set(path2, pathItem2)
+		}
+	}
+
+	for endpoint1, pathItem1 := range unrenamed1.Map() {
+		if pathItem2, pathParamsMap, ok := findEndpoint(config, endpoint1, unrenamed2); ok {
 			other[endpoint1] = &pathItemPair{
-				PathItem1:     pathItem1,
-				PathItem2:     pathItem2,
-				PathParamsMap: pathParamsMap,
+				PathItem1:      pathItem1,
+				PathItem2:      pathItem2,
+				PathParamsMap:  pathParamsMap,
+				OperationMoves: moves[endpoint1],
 			}
 		} else {
 			deleted.Set(endpoint1, pathItem1)
 		}
 	}
 
-	for endpoint2, pathItem2 := range paths2.Map() {
-		if _, _, ok := findEndpoint(config, endpoint2, paths1); !ok {
+	for endpoint2, pathItem2 := range unrenamed2.Map() {
+		if _, _, ok := findEndpoint(config, endpoint2, unrenamed1); !ok {
 			added.Set(endpoint2, pathItem2)
 		}
 	}
 
-	return added, deleted, other
+	return added, deleted, other, nil
 }
 
 func rewritePrefix(paths map[string]*openapi3.PathItem, strip, prepend string) *openapi3.Paths {
 	result := openapi3.NewPathsWithCapacity(len(paths))
 	for path, pathItem := range paths {
-		result.Set(prepend+strings.TrimPrefix(path, strip), pathItem)
+		result.Set(rewritePath(path, strip, prepend), pathItem)
 	}
 	return result
 }
@@ -0,0 +1,143 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/utils"
+	"gopkg.in/yaml.v3"
+)
+
+/*
+PathRenames maps paths in the base spec to their new names in the revision spec
+for example:
+/users/{id}: /accounts/{id}
+renamed paths are compared to each other instead of being reported as deleted and added
+*/
+type PathRenames map[string]string
+
+// ProcessPathRenames reads path renames from a YAML or JSON file
+func ProcessPathRenames(file string) (PathRenames, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return GetPathRenames(f)
+}
+
+// GetPathRenames reads path renames from a reader
+func GetPathRenames(source io.Reader) (PathRenames, error) {
+	var result PathRenames
+	if err := yaml.NewDecoder(source).Decode(&result); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to parse path renames: %w", err)
+	}
+	return result, nil
+}
+
+// add adds a rename from path1 to path2 unless path1 is already renamed to a different path
+func (pathRenames PathRenames) add(path1, path2 string) error {
+	if existing, ok := pathRenames[path1]; ok && existing != path2 {
+		return fmt.Errorf("path %q is renamed to both %q and %q", path1, existing, path2)
+	}
+	pathRenames[path1] = path2
+	return nil
+}
+
+/*
+getPathRenames combines the renames in the config with the renames declared by the x-oasdiff-renamed-from extension in the revision spec
+the extension can be set on path items or on operations, the operations of a path item must agree on the same original path
+paths are rewritten with the prefix options so that renames can be specified with the original paths of each spec
+*/
+func getPathRenames(config *Config, paths2 map[string]*openapi3.PathItem) (PathRenames, error) {
+	result := PathRenames{}
+
+	for path1, path2 := range config.PathRenames {
+		if err := result.add(rewritePath(path1, config.PathStripPrefixBase, config.PathPrefixBase), rewritePath(path2, config.PathStripPrefixRevision, config.PathPrefixRevision)); err != nil {
+			return nil, err
+		}
+	}
+
+	for path2, pathItem := range paths2 {
+		path1, err := getRenamedFrom(pathItem)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s extension in %s: %w", RenamedFromExtension, path2, err)
+		}
+		if path1 == "" {
+			continue
+		}
+		if err := result.add(rewritePath(path1, config.PathStripPrefixBase, config.PathPrefixBase), path2); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// getRenamedFrom returns the original path declared by the path item or by its operations
+func getRenamedFrom(pathItem *openapi3.PathItem) (string, error) {
+	if pathItem == nil {
+		return "", nil
+	}
+
+	result, err := getRenamedFromExtension(pathItem.Extensions)
+	if err != nil {
+		return "", err
+	}
+
+	for method, operation := range pathItem.Operations() {
+		path1, err := getRenamedFromExtension(operation.Extensions)
+		if err != nil {
+			return "", err
+		}
+		if path1 == "" {
+			continue
+		}
+		if result != "" && result != path1 {
+			return "", fmt.Errorf("operation %s is renamed from %q but the path is renamed from %q", method, path1, result)
+		}
+		result = path1
+	}
+
+	return result, nil
+}
+
+func getRenamedFromExtension(extensions map[string]any) (string, error) {
+	value, ok := extensions[RenamedFromExtension]
+	if !ok {
+		return "", nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case json.RawMessage:
+		var result string
+		if err := json.Unmarshal(v, &result); err != nil {
+			return "", fmt.Errorf("expected a string: %w", err)
+		}
+		return result, nil
+	default:
+		return "", fmt.Errorf("expected a string, got %T", value)
+	}
+}
+
+// getRenamedPathParamsMap maps the path params of renamed paths by position if both paths have the same number of params, and by name otherwise
+func getRenamedPathParamsMap(path1, path2 string) PathParamsMap {
+	_, _, pathParams1 := utils.NormalizeTemplatedPath(path1)
+	_, _, pathParams2 := utils.NormalizeTemplatedPath(path2)
+
+	if pathParamsMap, ok := NewPathParamsMap(pathParams1, pathParams2); ok {
+		return pathParamsMap
+	}
+	return PathParamsMap{}
+}
+
+func rewritePath(path, strip, prepend string) string {
+	return prepend + strings.TrimPrefix(path, strip)
+}
@@ -0,0 +1,122 @@
+package diff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func loadPathRenames(t *testing.T, file string) *openapi3.T {
+	t.Helper()
+	spec, err := openapi3.NewLoader().LoadFromFile("../data/path-renames/" + file)
+	require.NoError(t, err)
+	return spec
+}
+
+func TestPathRenames_Config(t *testing.T) {
+	config := diff.NewConfig()
+	config.PathRenames = diff.PathRenames{"/users/{id}": "/accounts/{accountId}"}
+
+	d, err := diff.Get(config, loadPathRenames(t, "base.yaml"), loadPathRenames(t, "revision.yaml"))
+	require.NoError(t, err)
+
+	require.Empty(t, d.PathsDiff.Added)
+	require.Empty(t, d.PathsDiff.Deleted)
+	require.Equal(t, &diff.ValueDiff{From: "/users/{id}", To: "/accounts/{accountId}"}, d.PathsDiff.Modified["/users/{id}"].RenameDiff)
+	require.Equal(t, utils.StringList{"fields"}, d.PathsDiff.Modified["/users/{id}"].OperationsDiff.Modified["GET"].ParametersDiff.Added["query"])
+
+	require.Empty(t, d.EndpointsDiff.Added)
+	require.Empty(t, d.EndpointsDiff.Deleted)
+	require.Contains(t, d.EndpointsDiff.Modified, diff.Endpoint{Method: "GET", Path: "/users/{id}"})
+}
+
+func TestPathRenames_Extension(t *testing.T) {
+	d, err := diff.Get(diff.NewConfig(), loadPathRenames(t, "base.yaml"), loadPathRenames(t, "revision-extension.yaml"))
+	require.NoError(t, err)
+
+	require.Empty(t, d.PathsDiff.Added)
+	require.Empty(t, d.PathsDiff.Deleted)
+	require.Equal(t, &diff.ValueDiff{From: "/users/{id}", To: "/accounts/{accountId}"}, d.PathsDiff.Modified["/users/{id}"].RenameDiff)
+}
+
+func TestPathRenames_OperationExtension(t *testing.T) {
+	s2 := loadPathRenames(t, "revision.yaml")
+	s2.Paths.Value("/accounts/{accountId}").Get.Extensions = map[string]any{diff.RenamedFromExtension: "/users/{id}"}
+
+	d, err := diff.Get(diff.NewConfig(), loadPathRenames(t, "base.yaml"), s2)
+	require.NoError(t, err)
+	require.NotNil(t, d.PathsDiff.Modified["/users/{id}"].RenameDiff)
+}
+
+func TestPathRenames_OperationExtensionConflict(t *testing.T) {
+	s2 := loadPathRenames(t, "revision-extension.yaml")
+	s2.Paths.Value("/accounts/{accountId}").Get.Extensions = map[string]any{diff.RenamedFromExtension: "/people/{id}"}
+
+	_, err := diff.Get(diff.NewConfig(), loadPathRenames(t, "base.yaml"), s2)
+	require.EqualError(t, err, `invalid x-oasdiff-renamed-from extension in /accounts/{accountId}: operation GET is renamed from "/people/{id}" but the path is renamed from "/users/{id}"`)
+}
+
+func TestPathRenames_ExtensionInvalid(t *testing.T) {
+	s2 := loadPathRenames(t, "revision.yaml")
+	s2.Paths.Value("/accounts/{accountId}").Extensions = map[string]any{diff.RenamedFromExtension: 5}
+
+	_, err := diff.Get(diff.NewConfig(), loadPathRenames(t, "base.yaml"), s2)
+	require.EqualError(t, err, "invalid x-oasdiff-renamed-from extension in /accounts/{accountId}: expected a string, got int")
+}
+
+func TestPathRenames_Conflict(t *testing.T) {
+	config := diff.NewConfig()
+	config.PathRenames = diff.PathRenames{"/users/{id}": "/people/{id}"}
+
+	_, err := diff.Get(config, loadPathRenames(t, "base.yaml"), loadPathRenames(t, "revision-extension.yaml"))
+	require.EqualError(t, err, `path "/users/{id}" is renamed to both "/people/{id}" and "/accounts/{accountId}"`)
+}
+
+func TestPathRenames_SameTarget(t *testing.T) {
+	config := diff.NewConfig()
+	config.PathRenames = diff.PathRenames{"/users/{id}": "/accounts/{accountId}", "/health": "/accounts/{accountId}"}
+
+	_, err := diff.Get(config, loadPathRenames(t, "base.yaml"), loadPathRenames(t, "revision.yaml"))
+	require.EqualError(t, err, `more than one path is renamed to "/accounts/{accountId}"`)
+}
+
+func TestPathRenames_MissingPath(t *testing.T) {
+	config := diff.NewConfig()
+	config.PathRenames = diff.PathRenames{"/users/{id}": "/members/{id}"}
+
+	d, err := diff.Get(config, loadPathRenames(t, "base.yaml"), loadPathRenames(t, "revision.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, utils.StringList{"/users/{id}"}, d.PathsDiff.Deleted)
+	require.Equal(t, utils.StringList{"/accounts/{accountId}"}, d.PathsDiff.Added)
+}
+
+func TestPathRenames_Prefix(t *testing.T) {
+	config := diff.NewConfig()
+	config.PathPrefixBase = "/v1"
+	config.PathPrefixRevision = "/v1"
+	config.PathRenames = diff.PathRenames{"/users/{id}": "/accounts/{accountId}"}
+
+	d, err := diff.Get(config, loadPathRenames(t, "base.yaml"), loadPathRenames(t, "revision.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, &diff.ValueDiff{From: "/v1/users/{id}", To: "/v1/accounts/{accountId}"}, d.PathsDiff.Modified["/v1/users/{id}"].RenameDiff)
+}
+
+func TestGetPathRenames(t *testing.T) {
+	pathRenames, err := diff.GetPathRenames(strings.NewReader("/users/{id}: /accounts/{id}\n"))
+	require.NoError(t, err)
+	require.Equal(t, diff.PathRenames{"/users/{id}": "/accounts/{id}"}, pathRenames)
+}
+
+func TestGetPathRenames_Invalid(t *testing.T) {
+	_, err := diff.GetPathRenames(strings.NewReader("- /users/{id}\n"))
+	require.ErrorContains(t, err, "failed to parse path renames")
+}
+
+func TestProcessPathRenames_NoFile(t *testing.T) {
+	_, err := diff.ProcessPathRenames("../data/path-renames/no-file.yaml")
+	require.Error(t, err)
+}
@@ -3,6 +3,7 @@ package diff
 import (
 	"fmt"
 	"regexp"
+	"sort"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oasdiff/oasdiff/utils"
@@ -61,7 +62,12 @@ func getPathsDiffInternal(config *Config, state *state, paths1, paths2 *openapi3
 	paths1Mod := rewritePrefix(paths1.Map(), config.PathStripPrefixBase, config.PathPrefixBase)
 	paths2Mod := rewritePrefix(paths2.Map(), config.PathStripPrefixRevision, config.PathPrefixRevision)
 
-	addedPaths, deletedPaths, otherPaths := getPathItemsDiff(config, paths1Mod, paths2Mod)
+	paths2Mod, moves := matchOperationIds(config, paths1Mod, paths2Mod)
+
+	addedPaths, deletedPaths, otherPaths, err := getPathItemsDiff(config, paths1Mod, paths2Mod, moves)
+	if err != nil {
+		return nil, err
+	}
 
 	for endpoint := range addedPaths.Map() {
 		result.addAddedPath(endpoint)
@@ -71,12 +77,18 @@ func getPathsDiffInternal(config *Config, state *state, paths1, paths2 *openapi3
 		result.addDeletedPath(endpoint)
 	}
 
-	for endpoint, pathItemPair := range otherPaths {
-		err := result.addModifiedPath(config, state, endpoint, pathItemPair)
-		if err != nil {
-			return nil, err
-		}
+	pathDiffs, err := getPathDiffs(config, state, otherPaths)
+	if err != nil {
+		return nil, err
 	}
+
+	for endpoint, pathDiff := range pathDiffs {
+		result.addModifiedPath(endpoint, pathDiff)
+	}
+	// sort the paths so that the output doesn't depend on map iteration order
+	sort.Strings(result.Added)
+	sort.Strings(result.Deleted)
+
 	result.Base = paths1Mod
 	result.Revision = paths2Mod
 
@@ -99,8 +111,8 @@ func (pathsDiff *PathsDiff) addDeletedPath(path string) {
 	pathsDiff.Deleted = append(pathsDiff.Deleted, path)
 }
 
-func (pathsDiff *PathsDiff) addModifiedPath(config *Config, state *state, path1 string, pathItemPair *pathItemPair) error {
-	return pathsDiff.Modified.addPathDiff(config, state, path1, pathItemPair)
+func (pathsDiff *PathsDiff) addModifiedPath(path1 string, pathDiff *PathDiff) {
+	pathsDiff.Modified.addPathDiff(path1, pathDiff)
 }
 
 func filterPaths(matchPath, unmatchPath, filterExtension string, paths1, paths2 *openapi3.Paths) error {
@@ -0,0 +1,48 @@
+package diff
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"golang.org/x/exp/slices"
+)
+
+// getPropertiesDiff compares the properties of two schemas, pairing renamed properties if Config.DetectPropertyRenames is set
+func getPropertiesDiff(config *Config, state *state, schema1, schema2 *openapi3.Schema) (*SchemasDiff, error) {
+	if !config.DetectPropertyRenames {
+		return getSchemasDiff(config, state, schema1.Properties, schema2.Properties)
+	}
+
+	return getSchemasDiffWithRenamer(config, state, schema1.Properties, schema2.Properties, getPropertyRenamer(schema1.Required, schema2.Required))
+}
+
+/*
+getPropertyRenamer returns a heuristic that pairs deleted properties with added properties that have an equal schema and the same required status
+a pair is only considered a rename if it is unambiguous: neither of the properties has an equal schema among the other deleted or added properties
+*/
+func getPropertyRenamer(required1, required2 []string) schemaRenamer {
+	return func(config *Config, deleted, added openapi3.Schemas) SchemaRenames {
+		result := SchemaRenames{}
+
+		matches1 := map[string][]string{}
+		matches2 := map[string][]string{}
+		for name1, schemaRef1 := range deleted {
+			fingerprint1 := getSchemaFingerprint(schemaRef1)
+			for name2, schemaRef2 := range added {
+				if slices.Contains(required1, name1) != slices.Contains(required2, name2) {
+					continue
+				}
+				if getSimilarity(fingerprint1, getSchemaFingerprint(schemaRef2)) == 1 {
+					matches1[name1] = append(matches1[name1], name2)
+					matches2[name2] = append(matches2[name2], name1)
+				}
+			}
+		}
+
+		for name1, names2 := range matches1 {
+			if len(names2) == 1 && len(matches2[names2[0]]) == 1 {
+				result[name1] = names2[0]
+			}
+		}
+
+		return result
+	}
+}
@@ -0,0 +1,97 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func getPropertyRenamesConfig() *diff.Config {
+	config := diff.NewConfig()
+	config.DetectPropertyRenames = true
+	return config
+}
+
+func getPropertyRenamesDiff(t *testing.T, config *diff.Config) *diff.Diff {
+	t.Helper()
+	loader := openapi3.NewLoader()
+	s1, err := loader.LoadFromFile("../data/property-renames/base.yaml")
+	require.NoError(t, err)
+	s2, err := loader.LoadFromFile("../data/property-renames/revision.yaml")
+	require.NoError(t, err)
+
+	d, err := diff.Get(config, s1, s2)
+	require.NoError(t, err)
+	return d
+}
+
+func getPropertyRenamesSchemaDiffs(d *diff.Diff) (*diff.SchemaDiff, *diff.SchemaDiff) {
+	operationDiff := d.PathsDiff.Modified["/users"].OperationsDiff.Modified["POST"]
+	return operationDiff.RequestBodyDiff.ContentDiff.MediaTypeModified["application/json"].SchemaDiff,
+		operationDiff.ResponsesDiff.Modified["200"].ContentDiff.MediaTypeModified["application/json"].SchemaDiff
+}
+
+func TestPropertyRenames(t *testing.T) {
+	request, response := getPropertyRenamesSchemaDiffs(getPropertyRenamesDiff(t, getPropertyRenamesConfig()))
+
+	require.Equal(t, diff.SchemaRenames{"userName": "username"}, request.PropertiesDiff.Renamed)
+	require.Empty(t, request.PropertiesDiff.Added)
+	require.Empty(t, request.PropertiesDiff.Deleted)
+
+	addressDiff := response.PropertiesDiff.Modified["address"]
+	require.Equal(t, diff.SchemaRenames{"zip": "postalCode"}, addressDiff.PropertiesDiff.Renamed)
+	require.Empty(t, addressDiff.PropertiesDiff.Added)
+	require.Empty(t, addressDiff.PropertiesDiff.Deleted)
+}
+
+func TestPropertyRenames_Disabled(t *testing.T) {
+	request, _ := getPropertyRenamesSchemaDiffs(getPropertyRenamesDiff(t, diff.NewConfig()))
+
+	require.Empty(t, request.PropertiesDiff.Renamed)
+	require.Equal(t, utils.StringList{"username"}, request.PropertiesDiff.Added)
+	require.Equal(t, utils.StringList{"userName"}, request.PropertiesDiff.Deleted)
+}
+
+func getPropertiesDiff(t *testing.T, schema1, schema2 *openapi3.Schema) *diff.SchemasDiff {
+	t.Helper()
+	d, err := diff.Get(getPropertyRenamesConfig(),
+		&openapi3.T{Components: &openapi3.Components{Schemas: openapi3.Schemas{"S": openapi3.NewSchemaRef("", schema1)}}},
+		&openapi3.T{Components: &openapi3.Components{Schemas: openapi3.Schemas{"S": openapi3.NewSchemaRef("", schema2)}}},
+	)
+	require.NoError(t, err)
+	return d.ComponentsDiff.SchemasDiff.Modified["S"].PropertiesDiff
+}
+
+func TestPropertyRenames_Ambiguous(t *testing.T) {
+	propertiesDiff := getPropertiesDiff(t,
+		openapi3.NewObjectSchema().WithProperty("a", openapi3.NewStringSchema()).WithProperty("b", openapi3.NewStringSchema()),
+		openapi3.NewObjectSchema().WithProperty("c", openapi3.NewStringSchema()),
+	)
+
+	require.Empty(t, propertiesDiff.Renamed)
+	require.Equal(t, utils.StringList{"c"}, propertiesDiff.Added)
+	require.ElementsMatch(t, utils.StringList{"a", "b"}, propertiesDiff.Deleted)
+}
+
+func TestPropertyRenames_RequiredChanged(t *testing.T) {
+	propertiesDiff := getPropertiesDiff(t,
+		openapi3.NewObjectSchema().WithProperty("a", openapi3.NewStringSchema()).WithRequired([]string{"a"}),
+		openapi3.NewObjectSchema().WithProperty("b", openapi3.NewStringSchema()),
+	)
+
+	require.Empty(t, propertiesDiff.Renamed)
+	require.Equal(t, utils.StringList{"b"}, propertiesDiff.Added)
+	require.Equal(t, utils.StringList{"a"}, propertiesDiff.Deleted)
+}
+
+func TestPropertyRenames_DifferentSchema(t *testing.T) {
+	propertiesDiff := getPropertiesDiff(t,
+		openapi3.NewObjectSchema().WithProperty("a", openapi3.NewStringSchema()),
+		openapi3.NewObjectSchema().WithProperty("b", openapi3.NewIntegerSchema()),
+	)
+
+	require.Empty(t, propertiesDiff.Renamed)
+}
@@ -64,6 +64,11 @@ func getSchemaDiff(config *Config, state *state, schema1, schema2 *openapi3.Sche
 		return diff, nil
 	}
 
+	// a circular reference is cut according to the schemas that this state is visiting, so its diff isn't cached
+	if diff, ok := getCircularSchemaDiff(state, schema1, schema2); ok {
+		return diff, nil
+	}
+
 	diff, err := getSchemaDiffInternal(config, state, schema1, schema2)
 	if err != nil {
 		return nil, err
@@ -77,6 +82,20 @@ func getSchemaDiff(config *Config, state *state, schema1, schema2 *openapi3.Sche
 	return diff, nil
 }
 
+func getCircularSchemaDiff(state *state, schema1, schema2 *openapi3.SchemaRef) (*SchemaDiff, bool) {
+	switch getCircularRefsDiff(state.visitedSchemasBase, state.visitedSchemasRevision, schema1, schema2) {
+	case circularRefStatusDiff:
+		return &SchemaDiff{
+			CircularRefDiff: true,
+			Base:            schema1.Value,
+			Revision:        schema2.Value,
+		}, true
+	case circularRefStatusNoDiff:
+		return nil, true
+	}
+	return nil, false
+}
+
 func getSchemaDiffInternal(config *Config, state *state, schema1, schema2 *openapi3.SchemaRef) (*SchemaDiff, error) {
 
 	if schema1 == nil && schema2 == nil {
@@ -102,16 +121,6 @@ func getSchemaDiffInternal(config *Config, state *state, schema1, schema2 *opena
 		Revision: value2,
 	}
 
-	if status := getCircularRefsDiff(state.visitedSchemasBase, state.visitedSchemasRevision, schema1, schema2); status != circularRefStatusNone {
-		switch status {
-		case circularRefStatusDiff:
-			result.CircularRefDiff = true
-			return &result, nil
-		case circularRefStatusNoDiff:
-			return &result, nil
-		}
-	}
-
 	// mark visited schema references to avoid infinite loops
 	if schema1.Ref != "" {
 		state.visitedSchemasBase.Add(schema1.Ref)
@@ -182,7 +191,7 @@ func getSchemaDiffInternal(config *Config, state *state, schema1, schema2 *opena
 
 	// Object
 	result.RequiredDiff = getRequiredPropertiesDiff(value1, value2)
-	result.PropertiesDiff, err = getSchemasDiff(config, state, value1.Properties, value2.Properties)
+	result.PropertiesDiff, err = getPropertiesDiff(config, state, value1, value2)
 	if err != nil {
 		return nil, err
 	}
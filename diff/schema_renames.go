@@ -0,0 +1,164 @@
+package diff
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+// SchemaRenames maps the names of schemas in base to their new names in revision
+type SchemaRenames map[string]string
+
+// DefaultSchemaRenameSimilarity disables the detection of renamed schemas
+const DefaultSchemaRenameSimilarity = 0.0
+
+// schemaDocumentationKeywords are ignored when comparing the structure of schemas because they are often changed along with the name of the schema
+var schemaDocumentationKeywords = utils.StringSet{
+	"title":       struct{}{},
+	"description": struct{}{},
+}
+
+// schemaStructurePrefixes are the fingerprint prefixes of keywords which give a schema a structure
+// schemas without any of them, like bare scalars, are too generic to be paired as renamed
+var schemaStructurePrefixes = []string{
+	"/$ref=",
+	"/properties/",
+	"/items/",
+	"/additionalProperties/",
+	"/allOf/",
+	"/anyOf/",
+	"/oneOf/",
+	"/not/",
+}
+
+type schemaRenameCandidate struct {
+	name1      string
+	name2      string
+	similarity float64
+}
+
+/*
+getSchemaRenames pairs deleted schemas with added schemas whose structure is similar enough to consider them renamed, see Config.SchemaRenameSimilarity
+each deleted schema is paired with at most one added schema, the most similar pairs are matched first
+schemas without structure, like bare scalars, are never paired
+*/
+func getSchemaRenames(config *Config, deleted, added openapi3.Schemas) SchemaRenames {
+	result := SchemaRenames{}
+
+	if config.SchemaRenameSimilarity <= 0 || len(deleted) == 0 || len(added) == 0 {
+		return result
+	}
+
+	fingerprints2 := map[string]utils.StringSet{}
+	for name2, schemaRef2 := range added {
+		if fingerprint2 := getSchemaFingerprint(schemaRef2); hasStructure(fingerprint2) {
+			fingerprints2[name2] = fingerprint2
+		}
+	}
+
+	candidates := []schemaRenameCandidate{}
+	for name1, schemaRef1 := range deleted {
+		fingerprint1 := getSchemaFingerprint(schemaRef1)
+		if !hasStructure(fingerprint1) {
+			continue
+		}
+		for name2, fingerprint2 := range fingerprints2 {
+			if similarity := getSimilarity(fingerprint1, fingerprint2); similarity >= config.SchemaRenameSimilarity {
+				candidates = append(candidates, schemaRenameCandidate{name1: name1, name2: name2, similarity: similarity})
+			}
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].similarity != candidates[j].similarity {
+			return candidates[i].similarity > candidates[j].similarity
+		}
+		if candidates[i].name1 != candidates[j].name1 {
+			return candidates[i].name1 < candidates[j].name1
+		}
+		return candidates[i].name2 < candidates[j].name2
+	})
+
+	renamedTo := utils.StringSet{}
+	for _, candidate := range candidates {
+		if _, ok := result[candidate.name1]; ok {
+			continue
+		}
+		if renamedTo.Contains(candidate.name2) {
+			continue
+		}
+		result[candidate.name1] = candidate.name2
+		renamedTo.Add(candidate.name2)
+	}
+
+	return result
+}
+
+// getSimilarity returns the Jaccard index of two fingerprints, schemas without any structure are never similar
+func getSimilarity(fingerprint1, fingerprint2 utils.StringSet) float64 {
+	union := len(fingerprint1) + len(fingerprint2)
+	if union == 0 {
+		return 0
+	}
+
+	intersection := len(fingerprint1.Intersection(fingerprint2))
+	return float64(intersection) / float64(union-intersection)
+}
+
+// hasStructure indicates whether the fingerprint contains references, properties, items or subschemas
+func hasStructure(fingerprint utils.StringSet) bool {
+	for leaf := range fingerprint {
+		for _, prefix := range schemaStructurePrefixes {
+			if strings.HasPrefix(leaf, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getSchemaFingerprint returns the leaves of the schema as a set of "path=value" strings, references to other schemas are not followed
+func getSchemaFingerprint(schemaRef *openapi3.SchemaRef) utils.StringSet {
+	result := utils.StringSet{}
+
+	if schemaRef == nil {
+		return result
+	}
+
+	data, err := json.Marshal(schemaRef)
+	if err != nil {
+		return result
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return result
+	}
+
+	addFingerprint(result, "", value, false)
+	return result
+}
+
+// addFingerprint adds the leaves of value to the fingerprint, isNameMap indicates that the keys of value are names, like the keys of properties, rather than keywords
+func addFingerprint(fingerprint utils.StringSet, path string, value any, isNameMap bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if !isNameMap && schemaDocumentationKeywords.Contains(key) {
+				continue
+			}
+			addFingerprint(fingerprint, path+"/"+key, child, !isNameMap && key == "properties")
+		}
+	case []any:
+		for i, child := range v {
+			addFingerprint(fingerprint, path+"/"+strconv.Itoa(i), child, false)
+		}
+	default:
+		data, _ := json.Marshal(v)
+		fingerprint.Add(path + "=" + string(data))
+	}
+}
@@ -0,0 +1,110 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func getSchemaRenamesDiff(t *testing.T, config *diff.Config) *diff.Diff {
+	t.Helper()
+	loader := openapi3.NewLoader()
+	s1, err := loader.LoadFromFile("../data/schema-renames/base.yaml")
+	require.NoError(t, err)
+	s2, err := loader.LoadFromFile("../data/schema-renames/revision.yaml")
+	require.NoError(t, err)
+
+	d, err := diff.Get(config, s1, s2)
+	require.NoError(t, err)
+	return d
+}
+
+func TestSchemaRenames_DisabledByDefault(t *testing.T) {
+	d := getSchemaRenamesDiff(t, diff.NewConfig())
+
+	schemasDiff := d.ComponentsDiff.SchemasDiff
+	require.Empty(t, schemasDiff.Renamed)
+	require.ElementsMatch(t, utils.StringList{"User", "Order"}, schemasDiff.Added)
+	require.ElementsMatch(t, utils.StringList{"UserDTO", "OrderDTO"}, schemasDiff.Deleted)
+}
+
+func TestSchemaRenames_Identical(t *testing.T) {
+	config := diff.NewConfig()
+	config.SchemaRenameSimilarity = 1
+
+	d := getSchemaRenamesDiff(t, config)
+
+	schemasDiff := d.ComponentsDiff.SchemasDiff
+	require.Equal(t, diff.SchemaRenames{"UserDTO": "User"}, schemasDiff.Renamed)
+	require.Equal(t, utils.StringList{"Order"}, schemasDiff.Added)
+	require.Equal(t, utils.StringList{"OrderDTO"}, schemasDiff.Deleted)
+
+	// title and description are ignored when detecting renames but they are still compared
+	require.Equal(t, &diff.ValueDiff{From: "UserDTO", To: "User"}, schemasDiff.Modified["UserDTO"].TitleDiff)
+	require.NotContains(t, schemasDiff.Modified, "User")
+}
+
+func TestSchemaRenames_Similar(t *testing.T) {
+	config := diff.NewConfig()
+	config.SchemaRenameSimilarity = 0.8
+
+	d := getSchemaRenamesDiff(t, config)
+
+	schemasDiff := d.ComponentsDiff.SchemasDiff
+	require.Equal(t, diff.SchemaRenames{"UserDTO": "User", "OrderDTO": "Order"}, schemasDiff.Renamed)
+	require.Empty(t, schemasDiff.Added)
+	require.Empty(t, schemasDiff.Deleted)
+	require.Equal(t, &diff.ValueDiff{From: nil, To: uint64(100)}, schemasDiff.Modified["OrderDTO"].PropertiesDiff.Modified["note"].MaxLengthDiff)
+	require.Equal(t, 2, d.GetSummary().Details[diff.SchemasDetail].Modified)
+}
+
+func TestSchemaRenames_Disabled(t *testing.T) {
+	config := diff.NewConfig()
+	config.SchemaRenameSimilarity = 0
+
+	d := getSchemaRenamesDiff(t, config)
+
+	schemasDiff := d.ComponentsDiff.SchemasDiff
+	require.Empty(t, schemasDiff.Renamed)
+	require.ElementsMatch(t, utils.StringList{"User", "Order"}, schemasDiff.Added)
+	require.ElementsMatch(t, utils.StringList{"UserDTO", "OrderDTO"}, schemasDiff.Deleted)
+}
+
+func TestSchemaRenames_MostSimilarFirst(t *testing.T) {
+	config := diff.NewConfig()
+	config.SchemaRenameSimilarity = 0.1
+
+	s1 := &openapi3.T{Components: &openapi3.Components{Schemas: openapi3.Schemas{
+		"A": openapi3.NewSchemaRef("", openapi3.NewObjectSchema().WithProperty("name", openapi3.NewStringSchema().WithMaxLength(10))),
+	}}}
+	s2 := &openapi3.T{Components: &openapi3.Components{Schemas: openapi3.Schemas{
+		"B": openapi3.NewSchemaRef("", openapi3.NewObjectSchema().WithProperty("name", openapi3.NewStringSchema())),
+		"C": openapi3.NewSchemaRef("", openapi3.NewObjectSchema().WithProperty("name", openapi3.NewStringSchema().WithMaxLength(10))),
+	}}}
+
+	d, err := diff.Get(config, s1, s2)
+	require.NoError(t, err)
+	require.Equal(t, diff.SchemaRenames{"A": "C"}, d.ComponentsDiff.SchemasDiff.Renamed)
+	require.Equal(t, utils.StringList{"B"}, d.ComponentsDiff.SchemasDiff.Added)
+}
+
+func TestSchemaRenames_Scalars(t *testing.T) {
+	config := diff.NewConfig()
+	config.SchemaRenameSimilarity = 1
+
+	s1 := &openapi3.T{Components: &openapi3.Components{Schemas: openapi3.Schemas{
+		"UserId": openapi3.NewSchemaRef("", openapi3.NewStringSchema()),
+	}}}
+	s2 := &openapi3.T{Components: &openapi3.Components{Schemas: openapi3.Schemas{
+		"Color": openapi3.NewSchemaRef("", openapi3.NewStringSchema()),
+	}}}
+
+	d, err := diff.Get(config, s1, s2)
+	require.NoError(t, err)
+	require.Empty(t, d.ComponentsDiff.SchemasDiff.Renamed)
+	require.Equal(t, utils.StringList{"Color"}, d.ComponentsDiff.SchemasDiff.Added)
+	require.Equal(t, utils.StringList{"UserId"}, d.ComponentsDiff.SchemasDiff.Deleted)
+}
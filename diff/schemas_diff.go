@@ -10,6 +10,7 @@ type SchemasDiff struct {
 	Added    utils.StringList   `json:"added,omitempty" yaml:"added,omitempty"`
 	Deleted  utils.StringList   `json:"deleted,omitempty" yaml:"deleted,omitempty"`
 	Modified ModifiedSchemasMap `json:"modified,omitempty" yaml:"modified,omitempty"`
+	Renamed  SchemaRenames      `json:"renamed,omitempty" yaml:"renamed,omitempty"`
 	Base     openapi3.Schemas   `json:"-" yaml:"-"`
 	Revision openapi3.Schemas   `json:"-" yaml:"-"`
 }
@@ -22,7 +23,8 @@ func (schemasDiff *SchemasDiff) Empty() bool {
 
 	return len(schemasDiff.Added) == 0 &&
 		len(schemasDiff.Deleted) == 0 &&
-		len(schemasDiff.Modified) == 0
+		len(schemasDiff.Modified) == 0 &&
+		len(schemasDiff.Renamed) == 0
 }
 
 func newSchemasDiff() *SchemasDiff {
@@ -40,8 +42,20 @@ type schemaRefPair struct {
 
 type schemaRefPairs map[string]*schemaRefPair
 
+// schemaRenamer pairs deleted schemas with added schemas that should be compared to each other as renamed schemas
+type schemaRenamer func(config *Config, deleted, added openapi3.Schemas) SchemaRenames
+
 func getSchemasDiff(config *Config, state *state, schemas1, schemas2 openapi3.Schemas) (*SchemasDiff, error) {
-	diff, err := getSchemasDiffInternal(config, state, schemas1, schemas2)
+	return getSchemasDiffWithRenamer(config, state, schemas1, schemas2, nil)
+}
+
+// getComponentSchemasDiff is like getSchemasDiff but it also compares renamed schemas to each other, see Config.SchemaRenameSimilarity
+func getComponentSchemasDiff(config *Config, state *state, schemas1, schemas2 openapi3.Schemas) (*SchemasDiff, error) {
+	return getSchemasDiffWithRenamer(config, state, schemas1, schemas2, getSchemaRenames)
+}
+
+func getSchemasDiffWithRenamer(config *Config, state *state, schemas1, schemas2 openapi3.Schemas, renamer schemaRenamer) (*SchemasDiff, error) {
+	diff, err := getSchemasDiffInternal(config, state, schemas1, schemas2, renamer)
 	if err != nil {
 		return nil, err
 	}
@@ -53,12 +67,24 @@ func getSchemasDiff(config *Config, state *state, schemas1, schemas2 openapi3.Sc
 	return diff, nil
 }
 
-func getSchemasDiffInternal(config *Config, state *state, schemas1, schemas2 openapi3.Schemas) (*SchemasDiff, error) {
+func getSchemasDiffInternal(config *Config, state *state, schemas1, schemas2 openapi3.Schemas, renamer schemaRenamer) (*SchemasDiff, error) {
 
 	result := newSchemasDiff()
 
 	addedSchemas, deletedSchemas, otherSchemas := diffSchemas(schemas1, schemas2)
 
+	if renamer != nil {
+		for name1, name2 := range renamer(config, deletedSchemas, addedSchemas) {
+			otherSchemas[name1] = &schemaRefPair{
+				SchemaRef1: deletedSchemas[name1],
+				SchemaRef2: addedSchemas[name2],
+			}
+			delete(deletedSchemas, name1)
+			delete(addedSchemas, name2)
+			result.addRenamedSchema(name1, name2)
+		}
+	}
+
 	for schema := range addedSchemas {
 		result.addAddedSchema(schema)
 	}
@@ -117,15 +143,30 @@ func (schemasDiff *SchemasDiff) addDeletedSchema(schema string) {
 	schemasDiff.Deleted = append(schemasDiff.Deleted, schema)
 }
 
+func (schemasDiff *SchemasDiff) addRenamedSchema(schema1, schema2 string) {
+	if schemasDiff.Renamed == nil {
+		schemasDiff.Renamed = SchemaRenames{}
+	}
+	schemasDiff.Renamed[schema1] = schema2
+}
+
 func (schemasDiff *SchemasDiff) addModifiedSchema(config *Config, state *state, schemaName string, schemaRef1, schemaRef2 *openapi3.SchemaRef) error {
 	return schemasDiff.Modified.addSchemaDiff(config, state, schemaName, schemaRef1, schemaRef2)
 }
 
 func (schemasDiff *SchemasDiff) getSummary() *SummaryDetails {
+	// renamed schemas are counted as modified even if their structure didn't change
+	modified := len(schemasDiff.Modified)
+	for schema := range schemasDiff.Renamed {
+		if _, ok := schemasDiff.Modified[schema]; !ok {
+			modified++
+		}
+	}
+
 	return &SummaryDetails{
 		Added:    len(schemasDiff.Added),
 		Deleted:  len(schemasDiff.Deleted),
-		Modified: len(schemasDiff.Modified),
+		Modified: modified,
 	}
 }
 
@@ -12,7 +12,7 @@ const (
 type state struct {
 	visitedSchemasBase     utils.VisitedRefs
 	visitedSchemasRevision utils.VisitedRefs
-	cache                  directionalSchemaDiffCache
+	cache                  *directionalSchemaDiffCache
 	direction              direction
 }
 
@@ -28,3 +28,11 @@ func newState() *state {
 func (state *state) setDirection(direction direction) {
 	state.direction = direction
 }
+
+// fork returns a state for diffing in a separate goroutine: it shares the schema diff cache and has its own visited refs
+func (state *state) fork() *state {
+	result := newState()
+	result.cache = state.cache
+	result.direction = state.direction
+	return result
+}
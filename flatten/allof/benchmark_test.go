@@ -0,0 +1,40 @@
+package allof_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/flatten/allof"
+	"github.com/oasdiff/oasdiff/utils/specgen"
+	"github.com/stretchr/testify/require"
+)
+
+func benchmarkMergeSpec(b *testing.B, options specgen.Options) {
+	b.ReportAllocs()
+	for b.Loop() {
+		// MergeSpec merges the spec in place, so each iteration needs its own spec
+		b.StopTimer()
+		spec := specgen.Generate(options)
+		b.StartTimer()
+
+		_, err := allof.MergeSpec(spec)
+		require.NoError(b, err)
+	}
+}
+
+func BenchmarkMergeSpec(b *testing.B) {
+	benchmarkMergeSpec(b, specgen.DefaultOptions())
+}
+
+func BenchmarkMergeSpec_DeepAllOf(b *testing.B) {
+	options := specgen.DefaultOptions()
+	options.AllOfDepth = 20
+	// each path merges its schemas again, so fewer paths keep this benchmark short
+	options.Paths = 200
+	benchmarkMergeSpec(b, options)
+}
+
+func BenchmarkMergeSpec_NoCircularRefs(b *testing.B) {
+	options := specgen.DefaultOptions()
+	options.Circular = false
+	benchmarkMergeSpec(b, options)
+}
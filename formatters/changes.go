@@ -16,6 +16,14 @@ type Change struct {
 	Section     string         `json:"section,omitempty" yaml:"section,omitempty"`
 	IsBreaking  bool           `json:"-" yaml:"-"`
 	Attributes  map[string]any `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+
+	// the fields below are available to changelog templates only
+	Tags            []string `json:"-" yaml:"-"`
+	SourceFile      string   `json:"-" yaml:"-"`
+	SourceLine      int      `json:"-" yaml:"-"`
+	SourceLineEnd   int      `json:"-" yaml:"-"`
+	SourceColumn    int      `json:"-" yaml:"-"`
+	SourceColumnEnd int      `json:"-" yaml:"-"`
 }
 
 type Changes []Change
@@ -23,18 +31,28 @@ type Changes []Change
 func NewChanges(originalChanges checker.Changes, l checker.Localizer) Changes {
 	changes := make(Changes, len(originalChanges))
 	for i, change := range originalChanges {
-		changes[i] = Change{
-			Section:     change.GetSection(),
-			Id:          change.GetId(),
-			Text:        change.GetUncolorizedText(l),
-			Comment:     change.GetComment(l),
-			Level:       change.GetLevel(),
-			Operation:   change.GetOperation(),
-			OperationId: change.GetOperationId(),
-			Path:        change.GetPath(),
-			Source:      change.GetSource(),
-			Attributes:  change.GetAttributes(),
-		}
+		changes[i] = newChange(change, l)
 	}
 	return changes
 }
+
+func newChange(change checker.Change, l checker.Localizer) Change {
+	return Change{
+		Section:         change.GetSection(),
+		Id:              change.GetId(),
+		Text:            change.GetUncolorizedText(l),
+		Comment:         change.GetComment(l),
+		Level:           change.GetLevel(),
+		Operation:       change.GetOperation(),
+		OperationId:     change.GetOperationId(),
+		Path:            change.GetPath(),
+		Source:          change.GetSource(),
+		IsBreaking:      change.IsBreaking(),
+		Attributes:      change.GetAttributes(),
+		SourceFile:      change.GetSourceFile(),
+		SourceLine:      change.GetSourceLine(),
+		SourceLineEnd:   change.GetSourceLineEnd(),
+		SourceColumn:    change.GetSourceColumn(),
+		SourceColumnEnd: change.GetSourceColumnEnd(),
+	}
+}
@@ -18,15 +18,9 @@ func GroupChanges(changes checker.Changes, l checker.Localizer) ChangesByEndpoin
 		case checker.ApiChange:
 			ep := Endpoint{Path: change.GetPath(), Operation: change.GetOperation()}
 			if c, ok := apiChanges[ep]; ok {
-				*c = append(*c, Change{
-					IsBreaking: change.IsBreaking(),
-					Text:       change.GetUncolorizedText(l),
-				})
+				*c = append(*c, newChange(change, l))
 			} else {
-				apiChanges[ep] = &Changes{Change{
-					IsBreaking: change.IsBreaking(),
-					Text:       change.GetUncolorizedText(l),
-				}}
+				apiChanges[ep] = &Changes{newChange(change, l)}
 			}
 		}
 	}
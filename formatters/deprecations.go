@@ -0,0 +1,43 @@
+package formatters
+
+import (
+	"github.com/oasdiff/oasdiff/checker"
+)
+
+// Deprecation is a resource marked as deprecated in a spec
+type Deprecation struct {
+	Kind      string `json:"kind" yaml:"kind"`
+	Operation string `json:"operation" yaml:"operation"`
+	Path      string `json:"path" yaml:"path"`
+	Name      string `json:"name,omitempty" yaml:"name,omitempty"`
+	In        string `json:"in,omitempty" yaml:"in,omitempty"`
+	Stability string `json:"stability,omitempty" yaml:"stability,omitempty"`
+	Sunset    string `json:"sunset,omitempty" yaml:"sunset,omitempty"`
+	Status    string `json:"status" yaml:"status"`
+	Error     string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// IsIssue returns true if the sunset is missing, invalid or past due
+func (deprecation Deprecation) IsIssue() bool {
+	return deprecation.Status != string(checker.DeprecationStatusOK)
+}
+
+type Deprecations []Deprecation
+
+func NewDeprecations(deprecations checker.Deprecations) Deprecations {
+	result := make(Deprecations, len(deprecations))
+	for i, deprecation := range deprecations {
+		result[i] = Deprecation{
+			Kind:      string(deprecation.Kind),
+			Operation: deprecation.Method,
+			Path:      deprecation.Path,
+			Name:      deprecation.Name,
+			In:        deprecation.In,
+			Stability: deprecation.Stability,
+			Sunset:    deprecation.Sunset,
+			Status:    string(deprecation.Status),
+			Error:     deprecation.Error,
+		}
+	}
+	return result
+}
@@ -0,0 +1,60 @@
+package formatters_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/stretchr/testify/require"
+)
+
+var testDeprecations = formatters.Deprecations{
+	{
+		Kind:      "operation",
+		Operation: "GET",
+		Path:      "/api/test",
+		Stability: "beta",
+		Sunset:    "2022-08-10",
+		Status:    "past-due",
+	},
+	{
+		Kind:      "parameter",
+		Operation: "GET",
+		Path:      "/api/test",
+		Name:      "id",
+		In:        "query",
+		Sunset:    "9999-08-10",
+		Status:    "ok",
+	},
+}
+
+func TestTextFormatter_RenderDeprecations(t *testing.T) {
+	out, err := textFormatter.RenderDeprecations(testDeprecations, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, "OPERATION PATH      KIND      NAME IN    STABILITY SUNSET     STATUS\nGET       /api/test operation -    -     beta      2022-08-10 past-due\nGET       /api/test parameter id   query -         9999-08-10 ok\n", string(out))
+}
+
+func TestJsonFormatter_RenderDeprecations(t *testing.T) {
+	out, err := jsonFormatter.RenderDeprecations(testDeprecations[:1], formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, `[{"kind":"operation","operation":"GET","path":"/api/test","stability":"beta","sunset":"2022-08-10","status":"past-due"}]`, string(out))
+}
+
+func TestMarkupFormatter_RenderDeprecations(t *testing.T) {
+	out, err := markupFormatter.RenderDeprecations(testDeprecations, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "| GET | /api/test | operation |  |  | beta | 2022-08-10 | :warning: past-due |\n")
+	require.Contains(t, string(out), "| GET | /api/test | parameter | id | query |  | 9999-08-10 | ok |\n")
+}
+
+func TestMarkupFormatter_RenderDeprecationsEmpty(t *testing.T) {
+	out, err := markupFormatter.RenderDeprecations(formatters.Deprecations{}, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "No deprecated resources")
+}
+
+func TestHtmlFormatter_RenderDeprecations(t *testing.T) {
+	out, err := htmlFormatter.RenderDeprecations(testDeprecations, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), `<td class="issue">past-due</td>`)
+	require.Contains(t, string(out), `<td>ok</td>`)
+}
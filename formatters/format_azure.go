@@ -0,0 +1,93 @@
+package formatters
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// Azure Pipelines only supports errors and warnings, info changes are printed as plain log lines
+// https://learn.microsoft.com/en-us/azure/devops/pipelines/scripts/logging-commands#logissue-log-an-error-or-warning
+var azureSeverity = map[checker.Level]string{
+	checker.ERR:  "error",
+	checker.WARN: "warning",
+}
+
+type AzureFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newAzureFormatter(l checker.Localizer) AzureFormatter {
+	return AzureFormatter{
+		Localizer: l,
+	}
+}
+
+func (f AzureFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	var buf bytes.Buffer
+
+	for _, change := range changes {
+		message := escapeAzureMessage(getPlainMessage(change, f.Localizer))
+
+		severity, ok := azureSeverity[change.GetLevel()]
+		if !ok {
+			buf.WriteString(message + "\n")
+			continue
+		}
+
+		params := []string{
+			"type=" + severity,
+			"code=" + escapeAzureProperty(change.GetId()),
+		}
+		if change.GetSourceFile() != "" {
+			params = append(params, "sourcepath="+escapeAzureProperty(change.GetSourceFile()))
+		}
+		if change.GetSourceLine() != 0 {
+			params = append(params, "linenumber="+strconv.Itoa(change.GetSourceLine()+1))
+		}
+		if change.GetSourceColumn() != 0 {
+			params = append(params, "columnnumber="+strconv.Itoa(change.GetSourceColumn()+1))
+		}
+
+		buf.WriteString(fmt.Sprintf("##vso[task.logissue %s;]%s\n", strings.Join(params, ";"), message))
+	}
+
+	// add error, warning and info counts as pipeline variables
+	levelCount := changes.GetLevelCount()
+	buf.WriteString(fmt.Sprintf("##vso[task.setvariable variable=error_count]%d\n", levelCount[checker.ERR]))
+	buf.WriteString(fmt.Sprintf("##vso[task.setvariable variable=warning_count]%d\n", levelCount[checker.WARN]))
+	buf.WriteString(fmt.Sprintf("##vso[task.setvariable variable=info_count]%d\n", levelCount[checker.INFO]))
+
+	return buf.Bytes(), nil
+}
+
+var azureMessageEscaper = strings.NewReplacer(
+	"%", "%AZP25",
+	"\r", "%0D",
+	"\n", "%0A",
+)
+
+var azurePropertyEscaper = strings.NewReplacer(
+	"%", "%AZP25",
+	"\r", "%0D",
+	"\n", "%0A",
+	";", "%3B",
+	"]", "%5D",
+)
+
+func escapeAzureMessage(s string) string {
+	return azureMessageEscaper.Replace(s)
+}
+
+func escapeAzureProperty(s string) string {
+	return azurePropertyEscaper.Replace(s)
+}
+
+func (f AzureFormatter) SupportedOutputs() []Output {
+	return []Output{OutputChangelog}
+}
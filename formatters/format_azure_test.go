@@ -0,0 +1,87 @@
+package formatters_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var azureFormatter = formatters.AzureFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestAzureLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatAzure), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.AzureFormatter{}, f)
+}
+
+func TestAzureFormatter_RenderChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+		checker.ApiChange{
+			Id:        "warning_id",
+			Level:     checker.WARN,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+		},
+		checker.ApiChange{
+			Id:        "notice_id",
+			Level:     checker.INFO,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+		},
+	}
+
+	output, err := azureFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "##vso[task.logissue type=error;code=change_id;sourcepath=openapi.yaml;]in API GET /api/test This is a breaking change.\n"+
+		"##vso[task.logissue type=warning;code=warning_id;]in API GET /api/test This is a warning.\n"+
+		"in API GET /api/test This is a notice.\n"+
+		"##vso[task.setvariable variable=error_count]1\n"+
+		"##vso[task.setvariable variable=warning_count]1\n"+
+		"##vso[task.setvariable variable=info_count]1\n", string(output))
+}
+
+func TestAzureFormatter_RenderChangelog_FileLocation(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:           "change_two_lines_id",
+			Level:        checker.ERR,
+			Operation:    http.MethodGet,
+			Path:         "/api/test",
+			SourceFile:   "specs/open;api.yaml",
+			SourceLine:   20,
+			SourceColumn: 5,
+		},
+	}
+
+	output, err := azureFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Contains(t, string(output), "##vso[task.logissue type=error;code=change_two_lines_id;sourcepath=specs/open%3Bapi.yaml;linenumber=21;columnnumber=6;]in API GET /api/test This is a breaking change.%0AThis is a second line.\n")
+}
+
+func TestAzureFormatter_NotImplemented(t *testing.T) {
+	_, err := azureFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = azureFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = azureFormatter.RenderChecks(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = azureFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+}
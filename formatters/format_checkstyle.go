@@ -0,0 +1,131 @@
+package formatters
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+var checkstyleSeverity = map[checker.Level]string{
+	checker.ERR:  "error",
+	checker.WARN: "warning",
+	checker.INFO: "info",
+}
+
+type CheckstyleResult struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []CheckstyleFile `xml:"file"`
+}
+
+type CheckstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []CheckstyleError `xml:"error"`
+}
+
+type CheckstyleError struct {
+	Line     int    `xml:"line,attr,omitempty"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// CheckstyleConfig lists the checks as a checkstyle configuration with a module for each check
+type CheckstyleConfig struct {
+	XMLName xml.Name           `xml:"module"`
+	Name    string             `xml:"name,attr"`
+	Modules []CheckstyleModule `xml:"module"`
+}
+
+type CheckstyleModule struct {
+	Name       string               `xml:"name,attr"`
+	Properties []CheckstyleProperty `xml:"property"`
+}
+
+type CheckstyleProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type CheckstyleFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newCheckstyleFormatter(l checker.Localizer) CheckstyleFormatter {
+	return CheckstyleFormatter{
+		Localizer: l,
+	}
+}
+
+func (f CheckstyleFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	result := CheckstyleResult{
+		Version: "4.3",
+		Files:   []CheckstyleFile{},
+	}
+
+	// changes are grouped by file in order of appearance
+	fileIndex := map[string]int{}
+	for _, change := range changes {
+		name := getSourcePath(change)
+		i, ok := fileIndex[name]
+		if !ok {
+			i = len(result.Files)
+			fileIndex[name] = i
+			result.Files = append(result.Files, CheckstyleFile{Name: name})
+		}
+
+		result.Files[i].Errors = append(result.Files[i].Errors, CheckstyleError{
+			Line:     getOneBased(change.GetSourceLine()),
+			Column:   getOneBased(change.GetSourceColumn()),
+			Severity: checkstyleSeverity[change.GetLevel()],
+			Message:  getPlainMessage(change, f.Localizer),
+			Source:   "oasdiff." + change.GetId(),
+		})
+	}
+
+	return marshalCheckstyle(result)
+}
+
+func (f CheckstyleFormatter) RenderChecks(checks Checks, opts RenderOpts) ([]byte, error) {
+	result := CheckstyleConfig{
+		Name:    "Checker",
+		Modules: make([]CheckstyleModule, len(checks)),
+	}
+
+	for i, check := range checks {
+		result.Modules[i] = CheckstyleModule{
+			Name: check.Id,
+			Properties: []CheckstyleProperty{
+				{Name: "severity", Value: check.Level},
+				{Name: "message", Value: f.Localizer(check.Description)},
+			},
+		}
+	}
+
+	return marshalCheckstyle(result)
+}
+
+func marshalCheckstyle(v any) ([]byte, error) {
+	output, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal checkstyle XML: %w", err)
+	}
+
+	return []byte(xml.Header + string(output)), nil
+}
+
+// getOneBased converts a zero-based source location to a one-based one, zero means that the location is unknown
+func getOneBased(location int) int {
+	if location == 0 {
+		return 0
+	}
+	return location + 1
+}
+
+func (f CheckstyleFormatter) SupportedOutputs() []Output {
+	return []Output{OutputChangelog, OutputChecks}
+}
@@ -0,0 +1,101 @@
+package formatters_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var checkstyleFormatter = formatters.CheckstyleFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestCheckstyleLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatCheckstyle), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.CheckstyleFormatter{}, f)
+}
+
+func TestCheckstyleFormatter_RenderChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:           "change_id",
+			Level:        checker.ERR,
+			Operation:    http.MethodGet,
+			Path:         "/api/test",
+			Source:       load.NewSource("openapi.yaml"),
+			SourceLine:   20,
+			SourceColumn: 4,
+		},
+		checker.ApiChange{
+			Id:        "warning_id",
+			Level:     checker.WARN,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+			Source:    load.NewSource("other.yaml"),
+		},
+		checker.ApiChange{
+			Id:        "notice_id",
+			Level:     checker.INFO,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+	}
+
+	output, err := checkstyleFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3">
+  <file name="openapi.yaml">
+    <error line="21" column="5" severity="error" message="in API GET /api/test This is a breaking change." source="oasdiff.change_id"></error>
+    <error severity="info" message="in API GET /api/test This is a notice." source="oasdiff.notice_id"></error>
+  </file>
+  <file name="other.yaml">
+    <error severity="warning" message="in API GET /api/test This is a warning." source="oasdiff.warning_id"></error>
+  </file>
+</checkstyle>`, string(output))
+}
+
+func TestCheckstyleFormatter_RenderChangelogEmpty(t *testing.T) {
+	output, err := checkstyleFormatter.RenderChangelog(checker.Changes{}, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3"></checkstyle>`, string(output))
+}
+
+func TestCheckstyleFormatter_RenderChecks(t *testing.T) {
+	checks := formatters.Checks{
+		{
+			Id:          "change_id",
+			Level:       "error",
+			Description: "This is a breaking change.",
+		},
+	}
+
+	output, err := checkstyleFormatter.RenderChecks(checks, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<module name="Checker">
+  <module name="change_id">
+    <property name="severity" value="error"></property>
+    <property name="message" value="This is a breaking change."></property>
+  </module>
+</module>`, string(output))
+}
+
+func TestCheckstyleFormatter_NotImplemented(t *testing.T) {
+	_, err := checkstyleFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = checkstyleFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = checkstyleFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+}
@@ -69,8 +69,12 @@ func (f GitHubActionsFormatter) RenderChangelog(changes checker.Changes, opts Re
 }
 
 func getMessage(change checker.Change, l checker.Localizer) string {
-	message := strings.ReplaceAll(change.GetUncolorizedText(l), "\n", "%0A")
-	return fmt.Sprintf("in API %s %s %s", change.GetOperation(), change.GetPath(), message)
+	return strings.ReplaceAll(getPlainMessage(change, l), "\n", "%0A")
+}
+
+// getPlainMessage returns the message of a change for formats which handle multi-line text themselves
+func getPlainMessage(change checker.Change, l checker.Localizer) string {
+	return fmt.Sprintf("in API %s %s %s", change.GetOperation(), change.GetPath(), change.GetUncolorizedText(l))
 }
 
 func (f GitHubActionsFormatter) SupportedOutputs() []Output {
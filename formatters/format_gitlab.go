@@ -0,0 +1,95 @@
+package formatters
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// https://docs.gitlab.com/ee/ci/testing/code_quality.html#code-quality-report-format
+var gitLabSeverity = map[checker.Level]string{
+	checker.ERR:  "critical",
+	checker.WARN: "major",
+	checker.INFO: "info",
+}
+
+type GitLabFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newGitLabFormatter(l checker.Localizer) GitLabFormatter {
+	return GitLabFormatter{
+		Localizer: l,
+	}
+}
+
+type GitLabIssue struct {
+	Description string         `json:"description"`
+	CheckName   string         `json:"check_name"`
+	Fingerprint string         `json:"fingerprint"`
+	Severity    string         `json:"severity"`
+	Location    GitLabLocation `json:"location"`
+}
+
+type GitLabLocation struct {
+	Path  string      `json:"path"`
+	Lines GitLabLines `json:"lines"`
+}
+
+type GitLabLines struct {
+	Begin int `json:"begin"`
+}
+
+func (f GitLabFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	issues := make([]GitLabIssue, len(changes))
+	for i, change := range changes {
+		issues[i] = GitLabIssue{
+			Description: getPlainMessage(change, f.Localizer),
+			CheckName:   change.GetId(),
+			Fingerprint: getFingerprint(change),
+			Severity:    gitLabSeverity[change.GetLevel()],
+			Location: GitLabLocation{
+				Path: getSourcePath(change),
+				Lines: GitLabLines{
+					Begin: change.GetSourceLine() + 1,
+				},
+			},
+		}
+	}
+
+	return json.MarshalIndent(issues, "", "  ")
+}
+
+// getFingerprint identifies a change regardless of its location in the spec and of the output language so that GitLab can track it across runs
+func getFingerprint(change checker.Change) string {
+	values := []string{
+		change.GetId(),
+		change.GetSection(),
+		change.GetOperation(),
+		change.GetPath(),
+	}
+	for _, arg := range change.GetArgs() {
+		values = append(values, fmt.Sprint(arg))
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join(values, "\x00")))
+	return hex.EncodeToString(hash[:])
+}
+
+// getSourcePath returns the file that contains the change, or the spec source if the file is unknown
+func getSourcePath(change checker.Change) string {
+	if file := change.GetSourceFile(); file != "" {
+		return file
+	}
+	return change.GetSource()
+}
+
+func (f GitLabFormatter) SupportedOutputs() []Output {
+	return []Output{OutputChangelog}
+}
@@ -0,0 +1,151 @@
+package formatters_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var gitLabFormatter = formatters.GitLabFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestGitLabLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatGitLab), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.GitLabFormatter{}, f)
+}
+
+func renderGitLab(t *testing.T, changes checker.Changes) []formatters.GitLabIssue {
+	t.Helper()
+	output, err := gitLabFormatter.RenderChangelog(changes, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+
+	var issues []formatters.GitLabIssue
+	require.NoError(t, json.Unmarshal(output, &issues))
+	return issues
+}
+
+func TestGitLabFormatter_RenderChangelog(t *testing.T) {
+	issues := renderGitLab(t, checker.Changes{
+		checker.ApiChange{
+			Id:         "change_id",
+			Level:      checker.ERR,
+			Operation:  http.MethodGet,
+			Path:       "/api/test",
+			Source:     load.NewSource("openapi.yaml"),
+			SourceLine: 20,
+		},
+		checker.ApiChange{
+			Id:        "warning_id",
+			Level:     checker.WARN,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+			Source:    load.NewSource("https://example.com/openapi.yaml"),
+		},
+		checker.ComponentChange{
+			Id:    "notice_id",
+			Level: checker.INFO,
+		},
+	})
+
+	require.Len(t, issues, 3)
+
+	require.Equal(t, "in API GET /api/test This is a breaking change.", issues[0].Description)
+	require.Equal(t, "change_id", issues[0].CheckName)
+	require.Equal(t, "critical", issues[0].Severity)
+	require.Equal(t, formatters.GitLabLocation{Path: "openapi.yaml", Lines: formatters.GitLabLines{Begin: 21}}, issues[0].Location)
+	require.Len(t, issues[0].Fingerprint, 64)
+
+	require.Equal(t, "major", issues[1].Severity)
+	require.Equal(t, formatters.GitLabLocation{Path: "https://example.com/openapi.yaml", Lines: formatters.GitLabLines{Begin: 1}}, issues[1].Location)
+
+	require.Equal(t, "info", issues[2].Severity)
+}
+
+func TestGitLabFormatter_StableFingerprint(t *testing.T) {
+	change := checker.ApiChange{
+		Id:         "change_id",
+		Level:      checker.ERR,
+		Operation:  http.MethodGet,
+		Path:       "/api/test",
+		SourceLine: 20,
+	}
+	moved := change
+	moved.SourceLine = 30
+	otherPath := change
+	otherPath.Path = "/api/other"
+
+	issues := renderGitLab(t, checker.Changes{change, moved, otherPath})
+	require.Equal(t, issues[0].Fingerprint, renderGitLab(t, checker.Changes{change})[0].Fingerprint)
+	require.Equal(t, issues[0].Fingerprint, issues[1].Fingerprint)
+	require.NotEqual(t, issues[0].Fingerprint, issues[2].Fingerprint)
+}
+
+func TestGitLabFormatter_FingerprintIgnoresLanguage(t *testing.T) {
+	changes := checker.Changes{
+		checker.ComponentChange{
+			Id:        checker.APISchemasRemovedId,
+			Level:     checker.ERR,
+			Args:      []any{"Pet"},
+			Component: "schemas",
+		},
+		checker.ComponentChange{
+			Id:        checker.APISchemasRemovedId,
+			Level:     checker.ERR,
+			Args:      []any{"User"},
+			Component: "schemas",
+		},
+	}
+
+	render := func(lang string) []formatters.GitLabIssue {
+		output, err := formatters.GitLabFormatter{Localizer: checker.NewLocalizer(lang)}.RenderChangelog(changes, formatters.NewRenderOpts(), nil)
+		require.NoError(t, err)
+		var issues []formatters.GitLabIssue
+		require.NoError(t, json.Unmarshal(output, &issues))
+		return issues
+	}
+
+	en, ru := render("en"), render("ru")
+	require.NotEqual(t, en[0].Description, ru[0].Description)
+	require.Equal(t, en[0].Fingerprint, ru[0].Fingerprint)
+	require.NotEqual(t, en[0].Fingerprint, en[1].Fingerprint)
+}
+
+func TestGitLabFormatter_Empty(t *testing.T) {
+	output, err := gitLabFormatter.RenderChangelog(checker.Changes{}, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "[]", string(output))
+}
+
+func TestGitLabFormatter_NotImplemented(t *testing.T) {
+	_, err := gitLabFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = gitLabFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = gitLabFormatter.RenderChecks(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = gitLabFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+}
+
+func TestGitLabFormatter_MultilineText(t *testing.T) {
+	issues := renderGitLab(t, checker.Changes{
+		checker.ApiChange{
+			Id:        "change_two_lines_id",
+			Level:     checker.ERR,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+		},
+	})
+	require.Equal(t, "in API GET /api/test This is a breaking change.\nThis is a second line.", issues[0].Description)
+}
@@ -25,6 +25,14 @@ func newHTMLFormatter(l checker.Localizer) HTMLFormatter {
 }
 
 func (f HTMLFormatter) RenderDiff(diff *diff.Diff, opts RenderOpts) ([]byte, error) {
+	if opts.Interactive {
+		reportAsString, err := report.GetInteractiveHTMLReport(diff, opts.Changes, f.Localizer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate interactive HTML report: %w", err)
+		}
+		return []byte(reportAsString), nil
+	}
+
 	reportAsString, err := report.GetHTMLReportAsString(diff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate HTML report: %w", err)
@@ -36,25 +44,40 @@ func (f HTMLFormatter) RenderDiff(diff *diff.Diff, opts RenderOpts) ([]byte, err
 //go:embed templates/changelog.html
 var changelogHtml string
 
-type TemplateData struct {
-	APIChanges      ChangesByEndpoint
-	BaseVersion     string
-	RevisionVersion string
-}
-
 func (f HTMLFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
-	tmpl := template.Must(template.New("changelog").Parse(changelogHtml))
-	return ExecuteHtmlTemplate(tmpl, GroupChanges(changes, f.Localizer), specInfoPair)
+	tmpl, err := template.New("changelog").Funcs(TemplateFuncs()).Parse(getTemplate(opts, changelogHtml))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	return ExecuteGroupedHtmlTemplate(tmpl, NewGroupedChanges(changes, f.Localizer), specInfoPair)
 }
 
+// ExecuteHtmlTemplate executes a changelog template with endpoint changes only, use ExecuteGroupedHtmlTemplate to include component and security changes
 func ExecuteHtmlTemplate(tmpl *template.Template, changes ChangesByEndpoint, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	return ExecuteGroupedHtmlTemplate(tmpl, newGroupedAPIChanges(changes), specInfoPair)
+}
+
+// ExecuteGroupedHtmlTemplate executes a changelog template with endpoint, component and security changes
+func ExecuteGroupedHtmlTemplate(tmpl *template.Template, changes GroupedChanges, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, newTemplateData(changes, specInfoPair)); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+//go:embed templates/deprecations.html
+var deprecationsHtml string
+
+func (f HTMLFormatter) RenderDeprecations(deprecations Deprecations, opts RenderOpts) ([]byte, error) {
+	tmpl := template.Must(template.New("deprecations").Parse(deprecationsHtml))
 	var out bytes.Buffer
-	if err := tmpl.Execute(&out, TemplateData{changes, specInfoPair.GetBaseVersion(), specInfoPair.GetRevisionVersion()}); err != nil {
+	if err := tmpl.Execute(&out, deprecations); err != nil {
 		return nil, err
 	}
 	return out.Bytes(), nil
 }
 
 func (f HTMLFormatter) SupportedOutputs() []Output {
-	return []Output{OutputDiff, OutputChangelog}
+	return []Output{OutputDiff, OutputChangelog, OutputDeprecations}
 }
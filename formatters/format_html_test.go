@@ -48,6 +48,12 @@ func TestHtmlFormatter_RenderDiff(t *testing.T) {
 	require.Equal(t, string(out), "<p>No changes</p>\n")
 }
 
+func TestHtmlFormatter_RenderDiffInteractive(t *testing.T) {
+	out, err := htmlFormatter.RenderDiff(nil, formatters.RenderOpts{Interactive: true})
+	require.NoError(t, err)
+	require.Contains(t, string(out), "<p>No changes</p>")
+}
+
 func TestHtmlFormatter_RenderChangelog(t *testing.T) {
 	testChanges := checker.Changes{
 		checker.ApiChange{
@@ -85,3 +91,10 @@ func TestExecuteHtmlTemplate_Err(t *testing.T) {
 	_, err := formatters.ExecuteHtmlTemplate(tmpl, nil, nil)
 	assert.Error(t, err)
 }
+
+func TestExecuteGroupedHtmlTemplate_Err(t *testing.T) {
+	tmpl := template.Must(template.New("changelog").Parse(changelogHtml))
+	tmpl.Tree = nil
+	_, err := formatters.ExecuteGroupedHtmlTemplate(tmpl, formatters.GroupedChanges{}, nil)
+	assert.Error(t, err)
+}
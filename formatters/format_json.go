@@ -3,6 +3,8 @@ package formatters
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"iter"
 	"reflect"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -22,17 +24,39 @@ func newJSONFormatter(l checker.Localizer) JSONFormatter {
 }
 
 func (f JSONFormatter) RenderDiff(diff *diff.Diff, opts RenderOpts) ([]byte, error) {
-	return printJSON(diff)
+	return printJSON(NewDiffOutput(diff))
 }
 
 func (f JSONFormatter) RenderSummary(diff *diff.Diff, opts RenderOpts) ([]byte, error) {
-	return printJSON(diff.GetSummary())
+	return printJSON(NewSummaryOutput(diff.GetSummary()))
 }
 
 func (f JSONFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
 	return printJSON(NewChanges(changes, f.Localizer))
 }
 
+// StreamChangelog writes the changes as a JSON array, marshaling one change at a time
+func (f JSONFormatter) StreamChangelog(w io.Writer, changes iter.Seq[checker.Change], opts RenderOpts) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	separator := ""
+	for change := range changes {
+		bytes, err := printChangeJSON(change, f.Localizer)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "%s%s", separator, bytes); err != nil {
+			return err
+		}
+		separator = ","
+	}
+
+	_, err := io.WriteString(w, "]")
+	return err
+}
+
 func (f JSONFormatter) RenderChecks(checks Checks, opts RenderOpts) ([]byte, error) {
 	return printJSON(checks)
 }
@@ -41,8 +65,20 @@ func (f JSONFormatter) RenderFlatten(spec *openapi3.T, opts RenderOpts) ([]byte,
 	return printJSON(spec)
 }
 
+func (f JSONFormatter) RenderSemver(semver *Semver, opts RenderOpts) ([]byte, error) {
+	return printJSON(semver)
+}
+
+func (f JSONFormatter) RenderDeprecations(deprecations Deprecations, opts RenderOpts) ([]byte, error) {
+	return printJSON(deprecations)
+}
+
+func (f JSONFormatter) RenderBundle(spec *openapi3.T, opts RenderOpts) ([]byte, error) {
+	return printJSON(spec)
+}
+
 func (f JSONFormatter) SupportedOutputs() []Output {
-	return []Output{OutputDiff, OutputSummary, OutputChangelog, OutputChecks, OutputFlatten}
+	return []Output{OutputDiff, OutputSummary, OutputChangelog, OutputChecks, OutputFlatten, OutputSemver, OutputDeprecations, OutputBundle}
 }
 
 func printJSON(output interface{}) ([]byte, error) {
@@ -57,3 +93,13 @@ func printJSON(output interface{}) ([]byte, error) {
 
 	return bytes, nil
 }
+
+// printChangeJSON marshals a single change with the same fields as the changes in the JSON changelog
+func printChangeJSON(change checker.Change, l checker.Localizer) ([]byte, error) {
+	bytes, err := json.Marshal(newChange(change, l))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	return bytes, nil
+}
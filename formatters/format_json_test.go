@@ -1,6 +1,8 @@
 package formatters_test
 
 import (
+	"bytes"
+	"slices"
 	"testing"
 
 	"github.com/oasdiff/oasdiff/checker"
@@ -31,6 +33,32 @@ func TestJsonFormatter_RenderChangelog(t *testing.T) {
 	require.Equal(t, "[{\"id\":\"change_id\",\"text\":\"This is a breaking change.\",\"level\":3,\"section\":\"components\"}]", string(out))
 }
 
+func TestJsonFormatter_StreamChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:    "change_id",
+			Level: checker.ERR,
+		},
+		checker.ComponentChange{
+			Id:    "change_id",
+			Level: checker.WARN,
+		},
+	}
+
+	expected, err := jsonFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, jsonFormatter.StreamChangelog(&out, slices.Values(testChanges), formatters.NewRenderOpts()))
+	require.Equal(t, string(expected), out.String())
+}
+
+func TestJsonFormatter_StreamChangelogEmpty(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, jsonFormatter.StreamChangelog(&out, slices.Values(checker.Changes{}), formatters.NewRenderOpts()))
+	require.Equal(t, "[]", out.String())
+}
+
 func TestJsonFormatter_RenderChecks(t *testing.T) {
 	checks := formatters.Checks{
 		{
@@ -57,8 +85,14 @@ func TestJsonFormatter_RenderFlatten(t *testing.T) {
 	require.Empty(t, string(out))
 }
 
+func TestJsonFormatter_RenderBundle(t *testing.T) {
+	out, err := jsonFormatter.RenderBundle(nil, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Empty(t, string(out))
+}
+
 func TestJsonFormatter_RenderSummary(t *testing.T) {
 	out, err := jsonFormatter.RenderSummary(nil, formatters.NewRenderOpts())
 	require.NoError(t, err)
-	require.Equal(t, `{"diff":false}`, string(out))
+	require.Equal(t, `{"schemaVersion":"1","diff":false}`, string(out))
 }
@@ -48,24 +48,34 @@ func newJUnitFormatter(l checker.Localizer) JUnitFormatter {
 }
 
 func (f JUnitFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	testSuites := JUnitTestSuites{TestSuites: []JUnitTestSuite{newJUnitTestSuite("OASDiff", changes, f.Localizer)}}
+	output, err := xml.MarshalIndent(testSuites, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal junit XML: %w", err)
+	}
+
+	return []byte(xml.Header + string(output)), nil
+}
+
+func newJUnitTestSuite(name string, changes checker.Changes, l checker.Localizer) JUnitTestSuite {
 	var testSuite = JUnitTestSuite{
 		Package:   "com.oasdiff",
 		Time:      "0",
 		Tests:     len(changes), // TODO: use GetAllRules for the test count / test case list in the future, once the list is complete
 		Errors:    0,
 		Failures:  len(changes),
-		Name:      "OASDiff",
+		Name:      name,
 		TestCases: []JUnitTestCase{},
 	}
 
 	for _, change := range changes {
 		testCase := JUnitTestCase{
 			Name:      change.GetId(),
-			Classname: "OASDiff",
+			Classname: name,
 			Time:      "0",
 			Failure: &JUnitFailure{
 				Message: "Breaking change detected",
-				CDATA:   change.GetUncolorizedText(f.Localizer),
+				CDATA:   change.GetUncolorizedText(l),
 			},
 		}
 		testSuite.TestCases = append(testSuite.TestCases, testCase)
@@ -76,19 +86,13 @@ func (f JUnitFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts
 	if len(changes) == 0 {
 		testCase := JUnitTestCase{
 			Name:      "no breaking changes detected",
-			Classname: "OASDiff",
+			Classname: name,
 			Time:      "0",
 		}
 		testSuite.TestCases = append(testSuite.TestCases, testCase)
 	}
 
-	testSuites := JUnitTestSuites{TestSuites: []JUnitTestSuite{testSuite}}
-	output, err := xml.MarshalIndent(testSuites, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal junit XML: %w", err)
-	}
-
-	return []byte(xml.Header + string(output)), nil
+	return testSuite
 }
 
 func (f JUnitFormatter) SupportedOutputs() []Output {
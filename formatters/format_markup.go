@@ -2,6 +2,7 @@ package formatters
 
 import (
 	"bytes"
+	"fmt"
 	"text/template"
 
 	_ "embed"
@@ -31,18 +32,39 @@ func (f MarkupFormatter) RenderDiff(diff *diff.Diff, opts RenderOpts) ([]byte, e
 var changelogMarkdown string
 
 func (f MarkupFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
-	tmpl := template.Must(template.New("changelog").Parse(changelogMarkdown))
-	return ExecuteTextTemplate(tmpl, GroupChanges(changes, f.Localizer), specInfoPair)
+	tmpl, err := template.New("changelog").Funcs(TemplateFuncs()).Parse(getTemplate(opts, changelogMarkdown))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	return ExecuteGroupedTextTemplate(tmpl, NewGroupedChanges(changes, f.Localizer), specInfoPair)
 }
 
+// ExecuteTextTemplate executes a changelog template with endpoint changes only, use ExecuteGroupedTextTemplate to include component and security changes
 func ExecuteTextTemplate(tmpl *template.Template, changes ChangesByEndpoint, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	return ExecuteGroupedTextTemplate(tmpl, newGroupedAPIChanges(changes), specInfoPair)
+}
+
+// ExecuteGroupedTextTemplate executes a changelog template with endpoint, component and security changes
+func ExecuteGroupedTextTemplate(tmpl *template.Template, changes GroupedChanges, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, newTemplateData(changes, specInfoPair)); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+//go:embed templates/deprecations.md
+var deprecationsMarkdown string
+
+func (f MarkupFormatter) RenderDeprecations(deprecations Deprecations, opts RenderOpts) ([]byte, error) {
+	tmpl := template.Must(template.New("deprecations").Parse(deprecationsMarkdown))
 	var out bytes.Buffer
-	if err := tmpl.Execute(&out, TemplateData{changes, specInfoPair.GetBaseVersion(), specInfoPair.GetRevisionVersion()}); err != nil {
+	if err := tmpl.Execute(&out, deprecations); err != nil {
 		return nil, err
 	}
 	return out.Bytes(), nil
 }
 
 func (f MarkupFormatter) SupportedOutputs() []Output {
-	return []Output{OutputDiff, OutputChangelog}
+	return []Output{OutputDiff, OutputChangelog, OutputDeprecations}
 }
@@ -58,3 +58,17 @@ func TestExecuteMarkupTemplate_Err(t *testing.T) {
 	_, err := formatters.ExecuteTextTemplate(&template.Template{}, nil, nil)
 	assert.Error(t, err)
 }
+
+func TestExecuteGroupedMarkupTemplate_Err(t *testing.T) {
+	_, err := formatters.ExecuteGroupedTextTemplate(&template.Template{}, formatters.GroupedChanges{}, nil)
+	assert.Error(t, err)
+}
+
+func TestExecuteTextTemplate_ChangesByEndpoint(t *testing.T) {
+	tmpl := template.Must(template.New("changelog").Parse("{{ range $endpoint, $changes := .APIChanges }}{{ $endpoint.Operation }} {{ $endpoint.Path }} {{ len $changes }}{{ end }} {{ len .ComponentChanges }} {{ len .Changes }}"))
+	out, err := formatters.ExecuteTextTemplate(tmpl, formatters.ChangesByEndpoint{
+		{Path: "/test", Operation: "GET"}: &formatters.Changes{{Id: "change_id"}},
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "GET /test 1 0 1", string(out))
+}
@@ -0,0 +1,66 @@
+package formatters
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// NDJSONFormatter writes newline delimited JSON (aka JSON Lines): one JSON object per line
+// The objects have the same fields as the ones of the JSON formatter
+type NDJSONFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newNDJSONFormatter(l checker.Localizer) NDJSONFormatter {
+	return NDJSONFormatter{
+		Localizer: l,
+	}
+}
+
+func (f NDJSONFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := f.StreamChangelog(&buf, slices.Values(changes), opts); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// StreamChangelog writes the changes one line at a time
+func (f NDJSONFormatter) StreamChangelog(w io.Writer, changes iter.Seq[checker.Change], opts RenderOpts) error {
+	separator := ""
+	for change := range changes {
+		bytes, err := printChangeJSON(change, f.Localizer)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "%s%s", separator, bytes); err != nil {
+			return err
+		}
+		separator = "\n"
+	}
+	return nil
+}
+
+// RenderChecks writes one check per line, with the same fields as the checks of the JSON formatter
+func (f NDJSONFormatter) RenderChecks(checks Checks, opts RenderOpts) ([]byte, error) {
+	lines := make([][]byte, 0, len(checks))
+	for _, check := range checks {
+		line, err := printJSON(&check)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+	return bytes.Join(lines, []byte("\n")), nil
+}
+
+func (f NDJSONFormatter) SupportedOutputs() []Output {
+	return []Output{OutputChangelog, OutputChecks}
+}
@@ -0,0 +1,87 @@
+package formatters_test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var ndjsonFormatter = formatters.NDJSONFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestNDJSONLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatNDJSON), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.NDJSONFormatter{}, f)
+}
+
+func TestNDJSONFormatter_RenderChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:    "change_id",
+			Level: checker.ERR,
+		},
+		checker.ComponentChange{
+			Id:    "change_id",
+			Level: checker.WARN,
+		},
+	}
+
+	out, err := ndjsonFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "{\"id\":\"change_id\",\"text\":\"This is a breaking change.\",\"level\":3,\"section\":\"components\"}\n{\"id\":\"change_id\",\"text\":\"This is a breaking change.\",\"level\":2,\"section\":\"components\"}", string(out))
+}
+
+func TestNDJSONFormatter_RenderChangelogEmpty(t *testing.T) {
+	out, err := ndjsonFormatter.RenderChangelog(checker.Changes{}, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Empty(t, out)
+}
+
+func TestNDJSONFormatter_StreamChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:    "change_id",
+			Level: checker.ERR,
+		},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, ndjsonFormatter.StreamChangelog(&out, slices.Values(testChanges), formatters.NewRenderOpts()))
+	require.Equal(t, "{\"id\":\"change_id\",\"text\":\"This is a breaking change.\",\"level\":3,\"section\":\"components\"}", out.String())
+}
+
+func TestNDJSONFormatter_RenderChecks(t *testing.T) {
+	checks := formatters.Checks{
+		{
+			Id:          "change_id",
+			Level:       "info",
+			Description: "This is a breaking change.",
+		},
+		{
+			Id:          "change_two_lines_id",
+			Level:       "error",
+			Description: "This is a breaking change.",
+		},
+	}
+
+	out, err := ndjsonFormatter.RenderChecks(checks, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, "{\"id\":\"change_id\",\"level\":\"info\",\"description\":\"This is a breaking change.\"}\n{\"id\":\"change_two_lines_id\",\"level\":\"error\",\"description\":\"This is a breaking change.\"}", string(out))
+}
+
+func TestNDJSONFormatter_NotImplemented(t *testing.T) {
+	var err error
+
+	_, err = ndjsonFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = ndjsonFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+}
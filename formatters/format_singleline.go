@@ -3,6 +3,8 @@ package formatters
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"iter"
 
 	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/load"
@@ -33,6 +35,17 @@ func (f SingleLineFormatter) RenderChangelog(changes checker.Changes, opts Rende
 	return result.Bytes(), nil
 }
 
+// StreamChangelog writes the changes one line at a time
+// The title is omitted because the number of changes isn't known until all of them have been written
+func (f SingleLineFormatter) StreamChangelog(w io.Writer, changes iter.Seq[checker.Change], opts RenderOpts) error {
+	for c := range changes {
+		if _, err := fmt.Fprintf(w, "%s\n\n", c.SingleLineError(f.Localizer, opts.ColorMode)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (f SingleLineFormatter) SupportedOutputs() []Output {
 	return []Output{OutputChangelog}
 }
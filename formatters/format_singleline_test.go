@@ -1,6 +1,8 @@
 package formatters_test
 
 import (
+	"bytes"
+	"slices"
 	"testing"
 
 	"github.com/oasdiff/oasdiff/checker"
@@ -33,6 +35,20 @@ func TestSingleLineFormatter_RenderChangelog(t *testing.T) {
 	require.Equal(t, "1 changes: 1 error, 0 warning, 0 info\nerror, in components/test This is a breaking change. [change_id]. \n\n", string(out))
 }
 
+func TestSingleLineFormatter_StreamChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Component: "test",
+		},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, singleLineFormatter.StreamChangelog(&out, slices.Values(testChanges), formatters.NewRenderOpts()))
+	require.Equal(t, "error, in components/test This is a breaking change. [change_id]. \n\n", out.String())
+}
+
 func TestSingleLineFormatter_NotImplemented(t *testing.T) {
 	var err error
 
@@ -0,0 +1,98 @@
+package formatters
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// https://www.jetbrains.com/help/teamcity/service-messages.html#Reporting+Inspections
+var teamCitySeverity = map[checker.Level]string{
+	checker.ERR:  "ERROR",
+	checker.WARN: "WARNING",
+	checker.INFO: "INFO",
+}
+
+type TeamCityFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newTeamCityFormatter(l checker.Localizer) TeamCityFormatter {
+	return TeamCityFormatter{
+		Localizer: l,
+	}
+}
+
+func (f TeamCityFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
+	var buf bytes.Buffer
+
+	// an inspection type must be reported before the inspections that refer to it
+	reported := map[string]struct{}{}
+	for _, change := range changes {
+		if _, ok := reported[change.GetId()]; !ok {
+			reported[change.GetId()] = struct{}{}
+			writeTeamCityMessage(&buf, "inspectionType",
+				"id", change.GetId(),
+				"name", change.GetId(),
+				"category", change.GetLevel().String(),
+				"description", change.GetId(),
+			)
+		}
+
+		attrs := []string{
+			"typeId", change.GetId(),
+			"message", getPlainMessage(change, f.Localizer),
+			"file", getSourcePath(change),
+		}
+		if line := getOneBased(change.GetSourceLine()); line != 0 {
+			attrs = append(attrs, "line", strconv.Itoa(line))
+		}
+		attrs = append(attrs, "SEVERITY", teamCitySeverity[change.GetLevel()])
+
+		writeTeamCityMessage(&buf, "inspection", attrs...)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (f TeamCityFormatter) RenderChecks(checks Checks, opts RenderOpts) ([]byte, error) {
+	var buf bytes.Buffer
+
+	for _, check := range checks {
+		writeTeamCityMessage(&buf, "inspectionType",
+			"id", check.Id,
+			"name", check.Id,
+			"category", check.Level,
+			"description", f.Localizer(check.Description),
+		)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeTeamCityMessage writes a service message with the given name and attribute name-value pairs
+func writeTeamCityMessage(buf *bytes.Buffer, name string, attrs ...string) {
+	params := make([]string, 0, len(attrs)/2)
+	for i := 0; i+1 < len(attrs); i += 2 {
+		params = append(params, fmt.Sprintf("%s='%s'", attrs[i], teamCityEscaper.Replace(attrs[i+1])))
+	}
+	buf.WriteString(fmt.Sprintf("##teamcity[%s %s]\n", name, strings.Join(params, " ")))
+}
+
+var teamCityEscaper = strings.NewReplacer(
+	"|", "||",
+	"'", "|'",
+	"\n", "|n",
+	"\r", "|r",
+	"[", "|[",
+	"]", "|]",
+)
+
+func (f TeamCityFormatter) SupportedOutputs() []Output {
+	return []Output{OutputChangelog, OutputChecks}
+}
@@ -0,0 +1,97 @@
+package formatters_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var teamCityFormatter = formatters.TeamCityFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestTeamCityLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatTeamCity), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.TeamCityFormatter{}, f)
+}
+
+func TestTeamCityFormatter_RenderChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:         "change_id",
+			Level:      checker.ERR,
+			Operation:  http.MethodGet,
+			Path:       "/api/test",
+			Source:     load.NewSource("openapi.yaml"),
+			SourceLine: 20,
+		},
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Operation: http.MethodPost,
+			Path:      "/api/test",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+		checker.ApiChange{
+			Id:        "change_two_lines_id",
+			Level:     checker.WARN,
+			Operation: http.MethodGet,
+			Path:      "/api/test/{id}",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+	}
+
+	output, err := teamCityFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Equal(t, "##teamcity[inspectionType id='change_id' name='change_id' category='error' description='change_id']\n"+
+		"##teamcity[inspection typeId='change_id' message='in API GET /api/test This is a breaking change.' file='openapi.yaml' line='21' SEVERITY='ERROR']\n"+
+		"##teamcity[inspection typeId='change_id' message='in API POST /api/test This is a breaking change.' file='openapi.yaml' SEVERITY='ERROR']\n"+
+		"##teamcity[inspectionType id='change_two_lines_id' name='change_two_lines_id' category='warning' description='change_two_lines_id']\n"+
+		"##teamcity[inspection typeId='change_two_lines_id' message='in API GET /api/test/{id} This is a breaking change.|nThis is a second line.' file='openapi.yaml' SEVERITY='WARNING']\n", string(output))
+}
+
+func TestTeamCityFormatter_Escape(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:        "it's|[x]",
+			Level:     checker.INFO,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+		},
+	}
+
+	output, err := teamCityFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Contains(t, string(output), "##teamcity[inspection typeId='it|'s|||[x|]' message='in API GET /api/test it|'s|||[x|]' file='' SEVERITY='INFO']\n")
+}
+
+func TestTeamCityFormatter_RenderChecks(t *testing.T) {
+	checks := formatters.Checks{
+		{
+			Id:          "change_id",
+			Level:       "error",
+			Description: "This is a breaking change.",
+		},
+	}
+
+	output, err := teamCityFormatter.RenderChecks(checks, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, "##teamcity[inspectionType id='change_id' name='change_id' category='error' description='This is a breaking change.']\n", string(output))
+}
+
+func TestTeamCityFormatter_NotImplemented(t *testing.T) {
+	_, err := teamCityFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = teamCityFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+
+	_, err = teamCityFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	assert.Error(t, err)
+}
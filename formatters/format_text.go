@@ -3,6 +3,7 @@ package formatters
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/oasdiff/oasdiff/checker"
@@ -53,6 +54,56 @@ func (f TEXTFormatter) RenderChecks(checks Checks, opts RenderOpts) ([]byte, err
 	return result.Bytes(), nil
 }
 
+func (f TEXTFormatter) RenderSemver(semver *Semver, opts RenderOpts) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+
+	w := tabwriter.NewWriter(result, 1, 1, 1, ' ', 0)
+	_, _ = fmt.Fprintln(w, "base version:\t"+semver.BaseVersion)
+	_, _ = fmt.Fprintln(w, "revision version:\t"+semver.RevisionVersion)
+	_, _ = fmt.Fprintln(w, "required bump:\t"+semver.RequiredBump)
+	if semver.DeclaredBump != "" {
+		_, _ = fmt.Fprintln(w, "declared bump:\t"+semver.DeclaredBump)
+	}
+	if semver.SuggestedVersion != "" {
+		_, _ = fmt.Fprintln(w, "suggested version:\t"+semver.SuggestedVersion)
+	}
+	if semver.Error != "" {
+		_, _ = fmt.Fprintln(w, "error:\t"+semver.Error)
+	}
+	_ = w.Flush()
+
+	return result.Bytes(), nil
+}
+
+func (f TEXTFormatter) RenderDeprecations(deprecations Deprecations, opts RenderOpts) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+
+	w := tabwriter.NewWriter(result, 1, 1, 1, ' ', 0)
+	_, _ = fmt.Fprintln(w, "OPERATION\tPATH\tKIND\tNAME\tIN\tSTABILITY\tSUNSET\tSTATUS")
+	for _, deprecation := range deprecations {
+		_, _ = fmt.Fprintln(w, strings.Join([]string{
+			deprecation.Operation,
+			deprecation.Path,
+			deprecation.Kind,
+			dashIfEmpty(deprecation.Name),
+			dashIfEmpty(deprecation.In),
+			dashIfEmpty(deprecation.Stability),
+			dashIfEmpty(deprecation.Sunset),
+			deprecation.Status,
+		}, "\t"))
+	}
+	_ = w.Flush()
+
+	return result.Bytes(), nil
+}
+
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
 func (f TEXTFormatter) SupportedOutputs() []Output {
-	return []Output{OutputDiff, OutputChangelog, OutputChecks}
+	return []Output{OutputDiff, OutputChangelog, OutputChecks, OutputSemver, OutputDeprecations}
 }
@@ -62,3 +62,18 @@ func TestTextFormatter_NotImplemented(t *testing.T) {
 	_, err = textFormatter.RenderSummary(nil, formatters.NewRenderOpts())
 	assert.Error(t, err)
 }
+
+func TestTextFormatter_RenderSemver(t *testing.T) {
+	semver := &formatters.Semver{
+		BaseVersion:      "1.0.0",
+		RevisionVersion:  "1.0.1",
+		RequiredBump:     "minor",
+		DeclaredBump:     "patch",
+		SuggestedVersion: "1.1.0",
+		Error:            "declared version bump patch is smaller than the required minor",
+	}
+
+	out, err := textFormatter.RenderSemver(semver, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, "base version:      1.0.0\nrevision version:  1.0.1\nrequired bump:     minor\ndeclared bump:     patch\nsuggested version: 1.1.0\nerror:             declared version bump patch is smaller than the required minor\n", string(out))
+}
@@ -22,11 +22,11 @@ func newYAMLFormatter(l checker.Localizer) YAMLFormatter {
 }
 
 func (f YAMLFormatter) RenderDiff(diff *diff.Diff, opts RenderOpts) ([]byte, error) {
-	return printYAML(diff)
+	return printYAML(NewDiffOutput(diff))
 }
 
 func (f YAMLFormatter) RenderSummary(diff *diff.Diff, opts RenderOpts) ([]byte, error) {
-	return printYAML(diff.GetSummary())
+	return printYAML(NewSummaryOutput(diff.GetSummary()))
 }
 
 func (f YAMLFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error) {
@@ -41,8 +41,20 @@ func (f YAMLFormatter) RenderFlatten(spec *openapi3.T, opts RenderOpts) ([]byte,
 	return printYAML(spec)
 }
 
+func (f YAMLFormatter) RenderSemver(semver *Semver, opts RenderOpts) ([]byte, error) {
+	return printYAML(semver)
+}
+
+func (f YAMLFormatter) RenderDeprecations(deprecations Deprecations, opts RenderOpts) ([]byte, error) {
+	return printYAML(deprecations)
+}
+
+func (f YAMLFormatter) RenderBundle(spec *openapi3.T, opts RenderOpts) ([]byte, error) {
+	return printYAML(spec)
+}
+
 func (f YAMLFormatter) SupportedOutputs() []Output {
-	return []Output{OutputDiff, OutputSummary, OutputChangelog, OutputChecks, OutputFlatten}
+	return []Output{OutputDiff, OutputSummary, OutputChangelog, OutputChecks, OutputFlatten, OutputSemver, OutputDeprecations, OutputBundle}
 }
 
 func printYAML(output interface{}) ([]byte, error) {
@@ -57,8 +57,14 @@ func TestYamlFormatter_RenderFlatten(t *testing.T) {
 	require.Empty(t, string(out))
 }
 
+func TestYamlFormatter_RenderBundle(t *testing.T) {
+	out, err := yamlFormatter.RenderBundle(nil, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Empty(t, string(out))
+}
+
 func TestYamlFormatter_RenderSummary(t *testing.T) {
 	out, err := yamlFormatter.RenderSummary(nil, formatters.NewRenderOpts())
 	require.NoError(t, err)
-	require.Equal(t, string(out), "diff: false\n")
+	require.Equal(t, string(out), "schemaVersion: \"1\"\ndiff: false\n")
 }
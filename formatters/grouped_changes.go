@@ -0,0 +1,48 @@
+package formatters
+
+import "github.com/oasdiff/oasdiff/checker"
+
+// ChangesByComponent groups component changes by the type of the component, like schemas or securitySchemes
+type ChangesByComponent map[string]*Changes
+
+// GroupedChanges groups changes by the section of the spec they apply to: endpoints, components and global security
+type GroupedChanges struct {
+	APIChanges       ChangesByEndpoint
+	ComponentChanges ChangesByComponent
+	SecurityChanges  Changes
+}
+
+func NewGroupedChanges(changes checker.Changes, l checker.Localizer) GroupedChanges {
+	result := GroupedChanges{
+		APIChanges:       GroupChanges(changes, l),
+		ComponentChanges: ChangesByComponent{},
+		SecurityChanges:  Changes{},
+	}
+
+	for _, change := range changes {
+		switch c := change.(type) {
+		case checker.ComponentChange:
+			if _, ok := result.ComponentChanges[c.Component]; !ok {
+				result.ComponentChanges[c.Component] = &Changes{}
+			}
+			*result.ComponentChanges[c.Component] = append(*result.ComponentChanges[c.Component], newChange(c, l))
+		case checker.SecurityChange:
+			result.SecurityChanges = append(result.SecurityChanges, newChange(c, l))
+		}
+	}
+
+	return result
+}
+
+// newGroupedAPIChanges returns grouped changes which contain endpoint changes only
+func newGroupedAPIChanges(changes ChangesByEndpoint) GroupedChanges {
+	if changes == nil {
+		changes = ChangesByEndpoint{}
+	}
+
+	return GroupedChanges{
+		APIChanges:       changes,
+		ComponentChanges: ChangesByComponent{},
+		SecurityChanges:  Changes{},
+	}
+}
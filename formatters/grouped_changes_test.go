@@ -0,0 +1,75 @@
+package formatters_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/stretchr/testify/require"
+)
+
+var sectionChanges = checker.Changes{
+	checker.ApiChange{
+		Id:        "api-deleted",
+		Level:     checker.ERR,
+		Operation: "GET",
+		Path:      "/test",
+	},
+	checker.ComponentChange{
+		Id:        "api-schema-removed",
+		Level:     checker.INFO,
+		Component: checker.ComponentSchemas,
+	},
+	checker.ComponentChange{
+		Id:        "api-security-component-removed",
+		Level:     checker.INFO,
+		Component: checker.ComponentSecuritySchemes,
+	},
+	checker.SecurityChange{
+		Id:    "api-security-removed",
+		Level: checker.ERR,
+	},
+}
+
+func TestNewGroupedChanges(t *testing.T) {
+	grouped := formatters.NewGroupedChanges(sectionChanges, MockLocalizer)
+
+	require.Len(t, grouped.APIChanges, 1)
+	require.Contains(t, grouped.APIChanges, formatters.Endpoint{Path: "/test", Operation: "GET"})
+
+	require.Len(t, grouped.ComponentChanges, 2)
+	require.Equal(t, formatters.Changes{{Id: "api-schema-removed", Text: "api-schema-removed", Level: checker.INFO, Section: "components"}}, *grouped.ComponentChanges[checker.ComponentSchemas])
+	require.Len(t, *grouped.ComponentChanges[checker.ComponentSecuritySchemes], 1)
+
+	require.Equal(t, formatters.Changes{{Id: "api-security-removed", Text: "api-security-removed", Level: checker.ERR, Section: "security", IsBreaking: true}}, grouped.SecurityChanges)
+}
+
+func TestNewGroupedChanges_Empty(t *testing.T) {
+	grouped := formatters.NewGroupedChanges(checker.Changes{}, MockLocalizer)
+	require.Empty(t, grouped.APIChanges)
+	require.Empty(t, grouped.ComponentChanges)
+	require.Empty(t, grouped.SecurityChanges)
+}
+
+func TestMarkupFormatter_RenderChangelogSections(t *testing.T) {
+	out, err := markupFormatter.RenderChangelog(sectionChanges, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Contains(t, string(out), "## Components\n\n### schemas\n-  api-schema-removed\n")
+	require.Contains(t, string(out), "### securitySchemes\n")
+	require.Contains(t, string(out), "## Security\n- :warning: api-security-removed\n")
+}
+
+func TestMarkupFormatter_RenderChangelogNoSections(t *testing.T) {
+	out, err := markupFormatter.RenderChangelog(sectionChanges[:1], formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.NotContains(t, string(out), "## Components")
+	require.NotContains(t, string(out), "## Security")
+}
+
+func TestHtmlFormatter_RenderChangelogSections(t *testing.T) {
+	out, err := htmlFormatter.RenderChangelog(sectionChanges, formatters.NewRenderOpts(), nil)
+	require.NoError(t, err)
+	require.Contains(t, string(out), `<div class="section-title">Components</div>`)
+	require.Contains(t, string(out), `<div class="">securitySchemes</div>`)
+	require.Contains(t, string(out), `<div class="section-title">Security</div>`)
+}
@@ -2,6 +2,8 @@ package formatters
 
 import (
 	"fmt"
+	"io"
+	"iter"
 	"sort"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -19,9 +21,18 @@ type Formatter interface {
 	RenderChangelog(changes checker.Changes, opts RenderOpts, specInfoPair *load.SpecInfoPair) ([]byte, error)
 	RenderChecks(checks Checks, opts RenderOpts) ([]byte, error)
 	RenderFlatten(spec *openapi3.T, opts RenderOpts) ([]byte, error)
+	RenderSemver(semver *Semver, opts RenderOpts) ([]byte, error)
+	RenderDeprecations(deprecations Deprecations, opts RenderOpts) ([]byte, error)
+	RenderBundle(spec *openapi3.T, opts RenderOpts) ([]byte, error)
 	SupportedOutputs() []Output
 }
 
+// StreamingFormatter is implemented by formatters that can write the changelog incrementally, one change at a time
+// This allows to render huge changelogs without holding all of their changes in memory
+type StreamingFormatter interface {
+	StreamChangelog(w io.Writer, changes iter.Seq[checker.Change], opts RenderOpts) error
+}
+
 var formatters = map[Format]Formatter{
 	FormatYAML:          YAMLFormatter{},
 	FormatJSON:          JSONFormatter{},
@@ -32,6 +43,11 @@ var formatters = map[Format]Formatter{
 	FormatHTML:          HTMLFormatter{},
 	FormatGithubActions: GitHubActionsFormatter{},
 	FormatJUnit:         JUnitFormatter{},
+	FormatGitLab:        GitLabFormatter{},
+	FormatAzure:         AzureFormatter{},
+	FormatCheckstyle:    CheckstyleFormatter{},
+	FormatTeamCity:      TeamCityFormatter{},
+	FormatNDJSON:        NDJSONFormatter{},
 }
 
 // Lookup returns a formatter by its name
@@ -56,6 +72,16 @@ func Lookup(format string, opts FormatterOpts) (Formatter, error) {
 		return newGitHubActionsFormatter(l), nil
 	case FormatJUnit:
 		return newJUnitFormatter(l), nil
+	case FormatGitLab:
+		return newGitLabFormatter(l), nil
+	case FormatAzure:
+		return newAzureFormatter(l), nil
+	case FormatCheckstyle:
+		return newCheckstyleFormatter(l), nil
+	case FormatTeamCity:
+		return newTeamCityFormatter(l), nil
+	case FormatNDJSON:
+		return newNDJSONFormatter(l), nil
 	default:
 		return nil, fmt.Errorf("unsupported format: %s", f)
 	}
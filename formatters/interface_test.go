@@ -33,7 +33,7 @@ func TestSummaryOutputFormats(t *testing.T) {
 
 func TestChangelogOutputFormats(t *testing.T) {
 	supportedFormats := formatters.SupportedFormatsByContentType(formatters.OutputChangelog)
-	assert.Len(t, supportedFormats, 9)
+	assert.Len(t, supportedFormats, 14)
 	assert.Contains(t, supportedFormats, string(formatters.FormatYAML))
 	assert.Contains(t, supportedFormats, string(formatters.FormatJSON))
 	assert.Contains(t, supportedFormats, string(formatters.FormatText))
@@ -43,4 +43,39 @@ func TestChangelogOutputFormats(t *testing.T) {
 	assert.Contains(t, supportedFormats, string(formatters.FormatHTML))
 	assert.Contains(t, supportedFormats, string(formatters.FormatGithubActions))
 	assert.Contains(t, supportedFormats, string(formatters.FormatJUnit))
+	assert.Contains(t, supportedFormats, string(formatters.FormatGitLab))
+	assert.Contains(t, supportedFormats, string(formatters.FormatAzure))
+	assert.Contains(t, supportedFormats, string(formatters.FormatCheckstyle))
+	assert.Contains(t, supportedFormats, string(formatters.FormatTeamCity))
+	assert.Contains(t, supportedFormats, string(formatters.FormatNDJSON))
+}
+
+func TestChecksOutputFormats(t *testing.T) {
+	supportedFormats := formatters.SupportedFormatsByContentType(formatters.OutputChecks)
+	assert.Len(t, supportedFormats, 6)
+	assert.Contains(t, supportedFormats, string(formatters.FormatYAML))
+	assert.Contains(t, supportedFormats, string(formatters.FormatJSON))
+	assert.Contains(t, supportedFormats, string(formatters.FormatText))
+	assert.Contains(t, supportedFormats, string(formatters.FormatCheckstyle))
+	assert.Contains(t, supportedFormats, string(formatters.FormatTeamCity))
+	assert.Contains(t, supportedFormats, string(formatters.FormatNDJSON))
+}
+
+func TestSemverOutputFormats(t *testing.T) {
+	supportedFormats := formatters.SupportedFormatsByContentType(formatters.OutputSemver)
+	assert.Len(t, supportedFormats, 3)
+	assert.Contains(t, supportedFormats, string(formatters.FormatYAML))
+	assert.Contains(t, supportedFormats, string(formatters.FormatJSON))
+	assert.Contains(t, supportedFormats, string(formatters.FormatText))
+}
+
+func TestDeprecationsOutputFormats(t *testing.T) {
+	supportedFormats := formatters.SupportedFormatsByContentType(formatters.OutputDeprecations)
+	assert.Len(t, supportedFormats, 6)
+	assert.Contains(t, supportedFormats, string(formatters.FormatYAML))
+	assert.Contains(t, supportedFormats, string(formatters.FormatJSON))
+	assert.Contains(t, supportedFormats, string(formatters.FormatText))
+	assert.Contains(t, supportedFormats, string(formatters.FormatMarkup))
+	assert.Contains(t, supportedFormats, string(formatters.FormatMarkdown))
+	assert.Contains(t, supportedFormats, string(formatters.FormatHTML))
 }
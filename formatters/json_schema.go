@@ -0,0 +1,218 @@
+package formatters
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+// SchemaVersion is the version of the JSON and YAML outputs, it is incremented whenever the shape of an output changes in a way that isn't backward compatible
+const SchemaVersion = "1"
+
+// DiffOutput is the JSON and YAML output of the diff command
+type DiffOutput struct {
+	SchemaVersion string `json:"schemaVersion" yaml:"schemaVersion"`
+	*diff.Diff    `yaml:",inline"`
+}
+
+func NewDiffOutput(d *diff.Diff) *DiffOutput {
+	if d == nil {
+		return nil
+	}
+	return &DiffOutput{SchemaVersion: SchemaVersion, Diff: d}
+}
+
+// SummaryOutput is the JSON and YAML output of the summary command
+type SummaryOutput struct {
+	SchemaVersion string `json:"schemaVersion" yaml:"schemaVersion"`
+	*diff.Summary `yaml:",inline"`
+}
+
+func NewSummaryOutput(summary *diff.Summary) *SummaryOutput {
+	if summary == nil {
+		return nil
+	}
+	return &SummaryOutput{SchemaVersion: SchemaVersion, Summary: summary}
+}
+
+// the types of the outputs which are described by a JSON schema, by output name
+var schemaOutputs = map[string]reflect.Type{
+	"diff":      reflect.TypeOf(DiffOutput{}),
+	"summary":   reflect.TypeOf(SummaryOutput{}),
+	"changelog": reflect.TypeOf(Changes{}),
+	"checks":    reflect.TypeOf(Checks{}),
+	"run-all":   reflect.TypeOf(RunAllOutput{}),
+}
+
+// GetSchemaOutputs returns the names of the outputs that have a JSON schema
+func GetSchemaOutputs() []string {
+	return []string{"diff", "summary", "changelog", "checks", "run-all"}
+}
+
+// GetJSONSchema returns a JSON schema (draft 2020-12) for the JSON output with the given name, generated from the Go types
+// The YAML outputs have the same structure
+func GetJSONSchema(output string) (map[string]any, error) {
+	t, ok := schemaOutputs[output]
+	if !ok {
+		return nil, fmt.Errorf("no schema for output %q", output)
+	}
+
+	g := newSchemaGenerator()
+	result := g.getSchema(t)
+	result["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	result["title"] = fmt.Sprintf("oasdiff %s output", output)
+	result["x-schema-version"] = SchemaVersion
+	if len(g.defs) > 0 {
+		result["$defs"] = g.defs
+	}
+
+	return result, nil
+}
+
+var (
+	levelType = reflect.TypeOf(checker.Level(0))
+	anyType   = reflect.TypeOf((*any)(nil)).Elem()
+)
+
+// schemaGenerator converts Go types to JSON schemas according to their json struct tags
+type schemaGenerator struct {
+	defs  map[string]any
+	names map[reflect.Type]string
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		defs:  map[string]any{},
+		names: map[reflect.Type]string{},
+	}
+}
+
+func (g *schemaGenerator) getSchema(t reflect.Type) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case levelType:
+		return map[string]any{
+			"type":        "integer",
+			"enum":        []int{int(checker.INFO), int(checker.WARN), int(checker.ERR)},
+			"description": "1: info, 2: warning, 3: error",
+		}
+	case anyType:
+		return map[string]any{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": g.getSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": g.getSchema(t.Elem())}
+	case reflect.Struct:
+		return g.getStructRef(t)
+	default:
+		return map[string]any{}
+	}
+}
+
+// getStructRef adds the struct to the definitions, if it isn't there yet, and returns a reference to it
+func (g *schemaGenerator) getStructRef(t reflect.Type) map[string]any {
+	name, ok := g.names[t]
+	if !ok {
+		name = g.getDefName(t)
+		g.names[t] = name
+		// the definition is added before the properties are generated to support recursive types like schema diffs
+		g.defs[name] = nil
+		g.defs[name] = g.getStructSchema(t)
+	}
+
+	return map[string]any{"$ref": "#/$defs/" + name}
+}
+
+func (g *schemaGenerator) getDefName(t reflect.Type) string {
+	name := t.Name()
+	if _, exists := g.defs[name]; !exists {
+		return name
+	}
+
+	// types with the same name in different packages are prefixed by their package name
+	pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+	return pkg + "." + name
+}
+
+func (g *schemaGenerator) getStructSchema(t reflect.Type) map[string]any {
+	properties := map[string]any{}
+	required := []string{}
+	g.addProperties(t, properties, &required)
+
+	result := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		result["required"] = required
+	}
+	return result
+}
+
+func (g *schemaGenerator) addProperties(t reflect.Type, properties map[string]any, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag, hasTag := field.Tag.Lookup("json")
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			continue
+		}
+
+		// untagged embedded structs are inlined like encoding/json does
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				g.addProperties(embedded, properties, required)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if !hasTag || name == "" {
+			name = field.Name
+		}
+
+		schema := g.getSchema(field.Type)
+		if !strings.Contains(options, "omitempty") {
+			*required = append(*required, name)
+			if isNullable(field.Type) {
+				schema = map[string]any{"anyOf": []any{schema, map[string]any{"type": "null"}}}
+			}
+		}
+		properties[name] = schema
+	}
+}
+
+// isNullable returns true for types which encoding/json encodes as null when they are nil
+func isNullable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		return t != anyType
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package formatters_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONSchema_Outputs(t *testing.T) {
+	for _, output := range formatters.GetSchemaOutputs() {
+		schema, err := formatters.GetJSONSchema(output)
+		require.NoError(t, err)
+		require.Equal(t, formatters.SchemaVersion, schema["x-schema-version"])
+		require.Equal(t, "https://json-schema.org/draft/2020-12/schema", schema["$schema"])
+
+		_, err = json.Marshal(schema)
+		require.NoError(t, err)
+	}
+}
+
+func TestJSONSchema_Invalid(t *testing.T) {
+	_, err := formatters.GetJSONSchema("invalid")
+	require.EqualError(t, err, `no schema for output "invalid"`)
+}
+
+func TestJSONSchema_Summary(t *testing.T) {
+	schema, err := formatters.GetJSONSchema("summary")
+	require.NoError(t, err)
+	require.Equal(t, "#/$defs/SummaryOutput", schema["$ref"])
+
+	defs := schema["$defs"].(map[string]any)
+	summary := defs["SummaryOutput"].(map[string]any)
+	require.Equal(t, []string{"schemaVersion", "diff"}, summary["required"])
+	require.Contains(t, summary["properties"], "details")
+}
+
+func TestJSONSchema_Changelog(t *testing.T) {
+	schema, err := formatters.GetJSONSchema("changelog")
+	require.NoError(t, err)
+	require.Equal(t, "array", schema["type"])
+	require.Equal(t, map[string]any{"$ref": "#/$defs/Change"}, schema["items"])
+
+	change := schema["$defs"].(map[string]any)["Change"].(map[string]any)
+	properties := change["properties"].(map[string]any)
+	require.Equal(t, "integer", properties["level"].(map[string]any)["type"])
+	require.NotContains(t, properties, "Tags")
+	require.NotContains(t, properties, "IsBreaking")
+}
+
+func TestJSONSchema_DiffRecursive(t *testing.T) {
+	schema, err := formatters.GetJSONSchema("diff")
+	require.NoError(t, err)
+	defs := schema["$defs"].(map[string]any)
+	require.Contains(t, defs, "SchemaDiff")
+	require.NotContains(t, defs, "Diff")
+}
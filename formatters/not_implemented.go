@@ -31,6 +31,18 @@ func (f notImplementedFormatter) RenderFlatten(*openapi3.T, RenderOpts) ([]byte,
 	return notImplemented()
 }
 
+func (f notImplementedFormatter) RenderSemver(*Semver, RenderOpts) ([]byte, error) {
+	return notImplemented()
+}
+
+func (f notImplementedFormatter) RenderDeprecations(Deprecations, RenderOpts) ([]byte, error) {
+	return notImplemented()
+}
+
+func (f notImplementedFormatter) RenderBundle(*openapi3.T, RenderOpts) ([]byte, error) {
+	return notImplemented()
+}
+
 func notImplemented() ([]byte, error) {
 	return nil, fmt.Errorf("not implemented")
 }
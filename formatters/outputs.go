@@ -8,4 +8,7 @@ const (
 	OutputChangelog
 	OutputChecks
 	OutputFlatten
+	OutputSemver
+	OutputDeprecations
+	OutputBundle
 )
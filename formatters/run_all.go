@@ -0,0 +1,182 @@
+package formatters
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// APIResult is the result of checking a single API of the run-all command
+type APIResult struct {
+	Name         string
+	Base         string
+	Revision     string
+	Changes      checker.Changes
+	SpecInfoPair *load.SpecInfoPair
+	Error        error
+	ExitCode     int
+}
+
+// RunAllOutput is the JSON output of the run-all command
+type RunAllOutput struct {
+	SchemaVersion string            `json:"schemaVersion" yaml:"schemaVersion"`
+	ExitCode      int               `json:"exitCode" yaml:"exitCode"`
+	APIs          []APIResultOutput `json:"apis" yaml:"apis"`
+}
+
+// APIResultOutput is the JSON output of a single API of the run-all command
+type APIResultOutput struct {
+	Name     string  `json:"name" yaml:"name"`
+	Base     string  `json:"base,omitempty" yaml:"base,omitempty"`
+	Revision string  `json:"revision,omitempty" yaml:"revision,omitempty"`
+	ExitCode int     `json:"exitCode" yaml:"exitCode"`
+	Error    string  `json:"error,omitempty" yaml:"error,omitempty"`
+	Changes  Changes `json:"changes" yaml:"changes"`
+}
+
+// GetRunAllFormats returns the formats supported by the run-all command
+func GetRunAllFormats() []string {
+	return []string{
+		string(FormatText),
+		string(FormatJSON),
+		string(FormatJUnit),
+		string(FormatMarkdown),
+	}
+}
+
+// GetRunAllExitCode combines the exit codes of the APIs into a single exit code: the highest one, so that errors take precedence over breaking changes
+func GetRunAllExitCode(results []APIResult) int {
+	result := 0
+	for _, apiResult := range results {
+		result = max(result, apiResult.ExitCode)
+	}
+	return result
+}
+
+// RenderRunAll renders the results of the run-all command as a single report with a section for each API
+func RenderRunAll(format string, results []APIResult, formatterOpts FormatterOpts, opts RenderOpts) ([]byte, error) {
+	l := checker.NewLocalizer(formatterOpts.Language)
+
+	switch Format(format) {
+	case FormatText:
+		return renderRunAllSections(results, newTEXTFormatter(l), opts, func(result APIResult) string {
+			return fmt.Sprintf("%s: %s -> %s\n", result.Name, result.Base, result.Revision)
+		}, nil)
+	case FormatMarkdown:
+		return renderRunAllSections(results, newMarkupFormatter(l), opts, func(result APIResult) string {
+			return fmt.Sprintf("# %s\n%s -> %s\n\n", result.Name, result.Base, result.Revision)
+		}, demoteMarkdownHeadings)
+	case FormatJSON:
+		return renderRunAllJSON(results, l)
+	case FormatJUnit:
+		return renderRunAllJUnit(results, l)
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+func renderRunAllSections(results []APIResult, formatter Formatter, opts RenderOpts, header func(result APIResult) string, transform func(string) string) ([]byte, error) {
+	var sb strings.Builder
+
+	for i, result := range results {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(header(result))
+
+		if result.Error != nil {
+			sb.WriteString(fmt.Sprintf("Error: %v\n", result.Error))
+			continue
+		}
+
+		output, err := formatter.RenderChangelog(result.Changes, opts, result.SpecInfoPair)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render %s: %w", result.Name, err)
+		}
+
+		section := string(output)
+		if transform != nil {
+			section = transform(section)
+		}
+		sb.WriteString(section)
+		if !strings.HasSuffix(section, "\n") {
+			sb.WriteString("\n")
+		}
+	}
+
+	return []byte(sb.String()), nil
+}
+
+// demoteMarkdownHeadings nests the headings of a changelog under the heading of its API
+func demoteMarkdownHeadings(markdown string) string {
+	lines := strings.Split(markdown, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "#") {
+			lines[i] = "#" + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func renderRunAllJSON(results []APIResult, l checker.Localizer) ([]byte, error) {
+	output := RunAllOutput{
+		SchemaVersion: SchemaVersion,
+		ExitCode:      GetRunAllExitCode(results),
+		APIs:          make([]APIResultOutput, len(results)),
+	}
+
+	for i, result := range results {
+		apiOutput := APIResultOutput{
+			Name:     result.Name,
+			Base:     result.Base,
+			Revision: result.Revision,
+			ExitCode: result.ExitCode,
+			Changes:  NewChanges(result.Changes, l),
+		}
+		if result.Error != nil {
+			apiOutput.Error = result.Error.Error()
+		}
+		output.APIs[i] = apiOutput
+	}
+
+	return json.MarshalIndent(output, "", "  ")
+}
+
+func renderRunAllJUnit(results []APIResult, l checker.Localizer) ([]byte, error) {
+	testSuites := JUnitTestSuites{TestSuites: []JUnitTestSuite{}}
+
+	for _, result := range results {
+		if result.Error != nil {
+			testSuites.TestSuites = append(testSuites.TestSuites, JUnitTestSuite{
+				Package: "com.oasdiff",
+				Time:    "0",
+				Tests:   1,
+				Errors:  1,
+				Name:    result.Name,
+				TestCases: []JUnitTestCase{{
+					Name:      "failed to check " + result.Name,
+					Classname: result.Name,
+					Time:      "0",
+					Failure: &JUnitFailure{
+						Message: "Failed to check API",
+						CDATA:   result.Error.Error(),
+					},
+				}},
+			})
+			continue
+		}
+
+		testSuites.TestSuites = append(testSuites.TestSuites, newJUnitTestSuite(result.Name, result.Changes, l))
+	}
+
+	output, err := xml.MarshalIndent(testSuites, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal junit XML: %w", err)
+	}
+
+	return []byte(xml.Header + string(output)), nil
+}
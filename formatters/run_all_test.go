@@ -0,0 +1,82 @@
+package formatters_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/stretchr/testify/require"
+)
+
+var runAllResults = []formatters.APIResult{
+	{
+		Name:     "users",
+		Base:     "users/base.yaml",
+		Revision: "users/revision.yaml",
+		Changes: checker.Changes{
+			checker.ApiChange{
+				Id:        "api-deleted",
+				Level:     checker.ERR,
+				Operation: "GET",
+				Path:      "/users",
+			},
+		},
+		ExitCode: 1,
+	},
+	{
+		Name:     "orders",
+		Base:     "orders/base.yaml",
+		Revision: "orders/revision.yaml",
+		Error:    errors.New("failed to load base spec"),
+		ExitCode: 102,
+	},
+}
+
+func TestGetRunAllExitCode(t *testing.T) {
+	require.Equal(t, 102, formatters.GetRunAllExitCode(runAllResults))
+	require.Equal(t, 1, formatters.GetRunAllExitCode(runAllResults[:1]))
+	require.Equal(t, 0, formatters.GetRunAllExitCode(nil))
+}
+
+func TestRenderRunAll_JSON(t *testing.T) {
+	out, err := formatters.RenderRunAll(string(formatters.FormatJSON), runAllResults, formatters.DefaultFormatterOpts(), formatters.NewRenderOpts())
+	require.NoError(t, err)
+
+	var output formatters.RunAllOutput
+	require.NoError(t, json.Unmarshal(out, &output))
+	require.Equal(t, 102, output.ExitCode)
+	require.Len(t, output.APIs, 2)
+	require.Equal(t, "users", output.APIs[0].Name)
+	require.Len(t, output.APIs[0].Changes, 1)
+	require.Equal(t, "failed to load base spec", output.APIs[1].Error)
+	require.Empty(t, output.APIs[1].Changes)
+}
+
+func TestRenderRunAll_Text(t *testing.T) {
+	out, err := formatters.RenderRunAll(string(formatters.FormatText), runAllResults, formatters.DefaultFormatterOpts(), formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "users: users/base.yaml -> users/revision.yaml")
+	require.Contains(t, string(out), "orders: orders/base.yaml -> orders/revision.yaml")
+	require.Contains(t, string(out), "failed to load base spec")
+}
+
+func TestRenderRunAll_Markdown(t *testing.T) {
+	out, err := formatters.RenderRunAll(string(formatters.FormatMarkdown), runAllResults, formatters.DefaultFormatterOpts(), formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "# users\n")
+	require.Contains(t, string(out), "# orders\n")
+}
+
+func TestRenderRunAll_JUnit(t *testing.T) {
+	out, err := formatters.RenderRunAll(string(formatters.FormatJUnit), runAllResults, formatters.DefaultFormatterOpts(), formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), `name="users"`)
+	require.Contains(t, string(out), `errors="1"`)
+}
+
+func TestRenderRunAll_Unsupported(t *testing.T) {
+	_, err := formatters.RenderRunAll(string(formatters.FormatHTML), runAllResults, formatters.DefaultFormatterOpts(), formatters.NewRenderOpts())
+	require.Error(t, err)
+}
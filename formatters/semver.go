@@ -0,0 +1,56 @@
+package formatters
+
+import (
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// Semver compares the version increment required by the changes with the one declared in the specs
+type Semver struct {
+	BaseVersion      string `json:"baseVersion" yaml:"baseVersion"`
+	RevisionVersion  string `json:"revisionVersion" yaml:"revisionVersion"`
+	RequiredBump     string `json:"requiredBump" yaml:"requiredBump"`
+	DeclaredBump     string `json:"declaredBump,omitempty" yaml:"declaredBump,omitempty"`
+	SuggestedVersion string `json:"suggestedVersion,omitempty" yaml:"suggestedVersion,omitempty"`
+	Valid            bool   `json:"valid" yaml:"valid"`
+	Error            string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// NewSemver classifies the changes and compares the result with the info.version of the base and revision specs
+func NewSemver(changes checker.Changes, specInfoPair *load.SpecInfoPair) *Semver {
+	required := checker.GetRequiredBump(changes)
+
+	result := &Semver{
+		BaseVersion:     specInfoPair.GetBaseVersion(),
+		RevisionVersion: specInfoPair.GetRevisionVersion(),
+		RequiredBump:    required.String(),
+	}
+
+	base, err := checker.ParseVersion(result.BaseVersion)
+	if err != nil {
+		result.Error = "base: " + err.Error()
+		return result
+	}
+	result.SuggestedVersion = base.Bump(required).String()
+
+	revision, err := checker.ParseVersion(result.RevisionVersion)
+	if err != nil {
+		result.Error = "revision: " + err.Error()
+		return result
+	}
+
+	declared, err := checker.GetDeclaredBump(base, revision)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.DeclaredBump = declared.String()
+
+	if declared < required {
+		result.Error = "declared version bump " + declared.String() + " is smaller than the required " + required.String()
+		return result
+	}
+
+	result.Valid = true
+	return result
+}
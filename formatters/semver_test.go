@@ -0,0 +1,60 @@
+package formatters_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func getSpecInfoPair(baseVersion, revisionVersion string) *load.SpecInfoPair {
+	return load.NewSpecInfoPair(
+		&load.SpecInfo{Spec: &openapi3.T{}, Version: baseVersion},
+		&load.SpecInfo{Spec: &openapi3.T{}, Version: revisionVersion},
+	)
+}
+
+var breakingChanges = checker.Changes{
+	checker.ApiChange{Id: checker.APIPathRemovedWithoutDeprecationId, Level: checker.ERR},
+}
+
+func TestNewSemver_Valid(t *testing.T) {
+	semver := formatters.NewSemver(breakingChanges, getSpecInfoPair("1.2.3", "2.0.0"))
+	require.True(t, semver.Valid)
+	require.Equal(t, "major", semver.RequiredBump)
+	require.Equal(t, "major", semver.DeclaredBump)
+	require.Equal(t, "2.0.0", semver.SuggestedVersion)
+	require.Empty(t, semver.Error)
+}
+
+func TestNewSemver_PreReleaseToRelease(t *testing.T) {
+	semver := formatters.NewSemver(breakingChanges, getSpecInfoPair("2.0.0-rc.1", "2.0.0"))
+	require.True(t, semver.Valid)
+	require.Equal(t, "major", semver.DeclaredBump)
+	require.Equal(t, "2.0.0", semver.SuggestedVersion)
+	require.Empty(t, semver.Error)
+}
+
+func TestNewSemver_TooSmall(t *testing.T) {
+	semver := formatters.NewSemver(breakingChanges, getSpecInfoPair("1.2.3", "1.3.0"))
+	require.False(t, semver.Valid)
+	require.Equal(t, "minor", semver.DeclaredBump)
+	require.Equal(t, "declared version bump minor is smaller than the required major", semver.Error)
+}
+
+func TestNewSemver_InvalidRevision(t *testing.T) {
+	semver := formatters.NewSemver(breakingChanges, getSpecInfoPair("1.2.3", "latest"))
+	require.False(t, semver.Valid)
+	require.Equal(t, "2.0.0", semver.SuggestedVersion)
+	require.Equal(t, `revision: invalid semantic version "latest": expected MAJOR.MINOR.PATCH`, semver.Error)
+}
+
+func TestNewSemver_NoSpecInfo(t *testing.T) {
+	semver := formatters.NewSemver(checker.Changes{}, nil)
+	require.False(t, semver.Valid)
+	require.Equal(t, "none", semver.RequiredBump)
+	require.Empty(t, semver.SuggestedVersion)
+}
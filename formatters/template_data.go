@@ -0,0 +1,143 @@
+package formatters
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// TemplateData is the data model passed to changelog templates, see docs/TEMPLATES.md
+type TemplateData struct {
+	APIChanges       ChangesByEndpoint
+	ComponentChanges ChangesByComponent
+	SecurityChanges  Changes
+	Changes          Changes // all changes: endpoint changes sorted by path and operation, followed by component and security changes
+	BaseVersion      string
+	RevisionVersion  string
+	Base             SpecSummary
+	Revision         SpecSummary
+	Date             time.Time // the time at which the changelog was generated
+}
+
+// SpecSummary describes one of the compared specs
+type SpecSummary struct {
+	Title       string
+	Description string
+	Version     string
+	Source      string
+}
+
+func newSpecSummary(specInfo *load.SpecInfo) SpecSummary {
+	result := SpecSummary{
+		Version: specInfo.GetVersion(),
+	}
+
+	if specInfo == nil {
+		return result
+	}
+
+	result.Source = specInfo.Url
+	if specInfo.Spec != nil && specInfo.Spec.Info != nil {
+		result.Title = specInfo.Spec.Info.Title
+		result.Description = specInfo.Spec.Info.Description
+	}
+
+	return result
+}
+
+func newTemplateData(changes GroupedChanges, specInfoPair *load.SpecInfoPair) TemplateData {
+	result := TemplateData{
+		APIChanges:       ChangesByEndpoint{},
+		ComponentChanges: changes.ComponentChanges,
+		SecurityChanges:  changes.SecurityChanges,
+		Changes:          Changes{},
+		BaseVersion:      specInfoPair.GetBaseVersion(),
+		RevisionVersion:  specInfoPair.GetRevisionVersion(),
+		Date:             time.Now(),
+	}
+
+	if specInfoPair != nil {
+		result.Base = newSpecSummary(specInfoPair.Base)
+		result.Revision = newSpecSummary(specInfoPair.Revision)
+	}
+
+	for _, endpoint := range sortedEndpoints(changes.APIChanges) {
+		tags := getOperationTags(specInfoPair, endpoint)
+		endpointChanges := make(Changes, len(*changes.APIChanges[endpoint]))
+		for i, change := range *changes.APIChanges[endpoint] {
+			change.Tags = tags
+			endpointChanges[i] = change
+		}
+		result.APIChanges[endpoint] = &endpointChanges
+		result.Changes = append(result.Changes, endpointChanges...)
+	}
+
+	for _, component := range sortedKeys(changes.ComponentChanges) {
+		result.Changes = append(result.Changes, *changes.ComponentChanges[component]...)
+	}
+
+	result.Changes = append(result.Changes, changes.SecurityChanges...)
+
+	return result
+}
+
+func sortedEndpoints(changes ChangesByEndpoint) []Endpoint {
+	result := make([]Endpoint, 0, len(changes))
+	for endpoint := range changes {
+		result = append(result, endpoint)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Path != result[j].Path {
+			return result[i].Path < result[j].Path
+		}
+		return result[i].Operation < result[j].Operation
+	})
+	return result
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// getOperationTags returns the tags of the endpoint in the revision followed by tags which only appear in the base, so that changes to deleted or re-tagged endpoints are grouped under their original tags too
+func getOperationTags(specInfoPair *load.SpecInfoPair, endpoint Endpoint) []string {
+	if specInfoPair == nil {
+		return nil
+	}
+
+	var result []string
+	for _, specInfo := range []*load.SpecInfo{specInfoPair.Revision, specInfoPair.Base} {
+		operation := findOperation(specInfo, endpoint)
+		if operation == nil {
+			continue
+		}
+		for _, tag := range operation.Tags {
+			if !slices.Contains(result, tag) {
+				result = append(result, tag)
+			}
+		}
+	}
+
+	return result
+}
+
+func findOperation(specInfo *load.SpecInfo, endpoint Endpoint) *openapi3.Operation {
+	if specInfo == nil || specInfo.Spec == nil || specInfo.Spec.Paths == nil {
+		return nil
+	}
+
+	pathItem := specInfo.Spec.Paths.Value(endpoint.Path)
+	if pathItem == nil {
+		return nil
+	}
+
+	return pathItem.GetOperation(endpoint.Operation)
+}
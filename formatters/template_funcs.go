@@ -0,0 +1,127 @@
+package formatters
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TemplateFuncs returns the helper functions available to changelog templates, see docs/TEMPLATES.md
+// The filters take the changes as their last argument so they can be used in pipelines, for example: {{ range .Changes | level "error" }}
+func TemplateFuncs() map[string]any {
+	return map[string]any{
+		"breaking":        filterBreaking,
+		"level":           filterLevel,
+		"section":         filterSection,
+		"id":              filterId,
+		"tag":             filterTag,
+		"groupByTag":      groupByTag,
+		"groupByLevel":    groupByLevel,
+		"groupBySection":  groupBySection,
+		"groupByEndpoint": groupByEndpoint,
+		"join":            func(sep string, elems []string) string { return strings.Join(elems, sep) },
+		"lower":           strings.ToLower,
+		"upper":           strings.ToUpper,
+	}
+}
+
+// toChanges accepts both Changes and *Changes because the grouped changes in TemplateData are pointers
+func toChanges(changes any) (Changes, error) {
+	switch c := changes.(type) {
+	case Changes:
+		return c, nil
+	case *Changes:
+		if c == nil {
+			return nil, nil
+		}
+		return *c, nil
+	default:
+		return nil, fmt.Errorf("expected changes, got %T", changes)
+	}
+}
+
+func filter(changes any, keep func(Change) bool) (Changes, error) {
+	all, err := toChanges(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := Changes{}
+	for _, change := range all {
+		if keep(change) {
+			result = append(result, change)
+		}
+	}
+	return result, nil
+}
+
+func filterBreaking(changes any) (Changes, error) {
+	return filter(changes, func(change Change) bool { return change.IsBreaking })
+}
+
+func filterLevel(level string, changes any) (Changes, error) {
+	return filter(changes, func(change Change) bool { return change.Level.String() == level })
+}
+
+func filterSection(section string, changes any) (Changes, error) {
+	return filter(changes, func(change Change) bool { return change.Section == section })
+}
+
+func filterId(id string, changes any) (Changes, error) {
+	return filter(changes, func(change Change) bool { return change.Id == id })
+}
+
+func filterTag(tag string, changes any) (Changes, error) {
+	return filter(changes, func(change Change) bool { return slices.Contains(change.Tags, tag) })
+}
+
+func group[K comparable](changes any, getKeys func(Change) []K) (map[K]Changes, error) {
+	all, err := toChanges(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[K]Changes{}
+	for _, change := range all {
+		for _, key := range getKeys(change) {
+			result[key] = append(result[key], change)
+		}
+	}
+	return result, nil
+}
+
+// groupByTag groups changes by the tags of their operation, a change with several tags appears in each of them and a change without tags appears under an empty tag
+func groupByTag(changes any) (map[string]Changes, error) {
+	return group(changes, func(change Change) []string {
+		if len(change.Tags) == 0 {
+			return []string{""}
+		}
+		return change.Tags
+	})
+}
+
+func groupByLevel(changes any) (map[string]Changes, error) {
+	return group(changes, func(change Change) []string { return []string{change.Level.String()} })
+}
+
+func groupBySection(changes any) (map[string]Changes, error) {
+	return group(changes, func(change Change) []string { return []string{change.Section} })
+}
+
+// groupByEndpoint groups endpoint changes by path and operation, other changes are omitted
+func groupByEndpoint(changes any) (map[Endpoint]Changes, error) {
+	return group(changes, func(change Change) []Endpoint {
+		if change.Path == "" {
+			return nil
+		}
+		return []Endpoint{{Path: change.Path, Operation: change.Operation}}
+	})
+}
+
+// getTemplate returns the custom template if one was specified and the default one otherwise
+func getTemplate(opts RenderOpts, defaultTemplate string) string {
+	if opts.Template != "" {
+		return opts.Template
+	}
+	return defaultTemplate
+}
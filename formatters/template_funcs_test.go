@@ -0,0 +1,129 @@
+package formatters_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+var templateChanges = checker.Changes{
+	checker.ApiChange{
+		Id:         "api-deleted",
+		Level:      checker.ERR,
+		Operation:  "GET",
+		Path:       "/users",
+		SourceFile: "base.yaml",
+		SourceLine: 12,
+	},
+	checker.ApiChange{
+		Id:        "api-added",
+		Level:     checker.INFO,
+		Operation: "POST",
+		Path:      "/pets",
+	},
+	checker.ComponentChange{
+		Id:        "api-schema-removed",
+		Level:     checker.INFO,
+		Component: checker.ComponentSchemas,
+	},
+}
+
+func newTemplateSpec(title string, path string, tag string) *load.SpecInfo {
+	paths := openapi3.NewPaths()
+	paths.Set(path, &openapi3.PathItem{Get: &openapi3.Operation{Tags: []string{tag}}, Post: &openapi3.Operation{Tags: []string{tag}}})
+	return &load.SpecInfo{
+		Url:     title + ".yaml",
+		Version: "1.0.0",
+		Spec: &openapi3.T{
+			Info:  &openapi3.Info{Title: title},
+			Paths: paths,
+		},
+	}
+}
+
+func renderTemplate(t *testing.T, tmpl string) string {
+	t.Helper()
+	specInfoPair := load.NewSpecInfoPair(newTemplateSpec("Users", "/users", "users"), newTemplateSpec("Pets", "/pets", "pets"))
+	out, err := markupFormatter.RenderChangelog(templateChanges, formatters.RenderOpts{Template: tmpl}, specInfoPair)
+	require.NoError(t, err)
+	return string(out)
+}
+
+func TestTemplate_SpecInfo(t *testing.T) {
+	require.Equal(t, "Users Users.yaml Pets Pets.yaml 1.0.0", renderTemplate(t, "{{ .Base.Title }} {{ .Base.Source }} {{ .Revision.Title }} {{ .Revision.Source }} {{ .Revision.Version }}"))
+}
+
+func TestTemplate_Date(t *testing.T) {
+	require.NotEqual(t, "0001", renderTemplate(t, `{{ .Date.Format "2006" }}`))
+}
+
+func TestTemplate_Changes(t *testing.T) {
+	require.Equal(t, "api-added api-deleted api-schema-removed ", renderTemplate(t, "{{ range .Changes }}{{ .Id }} {{ end }}"))
+}
+
+func TestTemplate_SourceLocation(t *testing.T) {
+	require.Equal(t, "base.yaml:12", renderTemplate(t, `{{ range .Changes | breaking }}{{ .SourceFile }}:{{ .SourceLine }}{{ end }}`))
+}
+
+func TestTemplate_FilterLevel(t *testing.T) {
+	require.Equal(t, "api-deleted", renderTemplate(t, `{{ range .Changes | level "error" }}{{ .Id }}{{ end }}`))
+}
+
+func TestTemplate_FilterSection(t *testing.T) {
+	require.Equal(t, "api-schema-removed", renderTemplate(t, `{{ range .Changes | section "components" }}{{ .Id }}{{ end }}`))
+}
+
+func TestTemplate_FilterId(t *testing.T) {
+	require.Equal(t, "POST /pets", renderTemplate(t, `{{ range .Changes | id "api-added" }}{{ .Operation }} {{ .Path }}{{ end }}`))
+}
+
+func TestTemplate_FilterTag(t *testing.T) {
+	require.Equal(t, "api-deleted", renderTemplate(t, `{{ range .Changes | tag "users" }}{{ .Id }}{{ end }}`))
+}
+
+func TestTemplate_GroupByTag(t *testing.T) {
+	require.Equal(t, ":1 pets:1 users:1 ", renderTemplate(t, `{{ range $tag, $changes := groupByTag .Changes }}{{ $tag }}:{{ len $changes }} {{ end }}`))
+}
+
+func TestTemplate_GroupByLevel(t *testing.T) {
+	require.Equal(t, "error:1 info:2 ", renderTemplate(t, `{{ range $level, $changes := groupByLevel .Changes }}{{ $level }}:{{ len $changes }} {{ end }}`))
+}
+
+func TestTemplate_GroupBySection(t *testing.T) {
+	require.Equal(t, "components:1 paths:2 ", renderTemplate(t, `{{ range $section, $changes := groupBySection .Changes }}{{ $section }}:{{ len $changes }} {{ end }}`))
+}
+
+func TestTemplate_GroupByEndpoint(t *testing.T) {
+	require.Equal(t, "POST /pets GET /users ", renderTemplate(t, `{{ range $endpoint, $changes := groupByEndpoint .Changes }}{{ $endpoint.Operation }} {{ $endpoint.Path }} {{ end }}`))
+}
+
+func TestTemplate_APIChanges(t *testing.T) {
+	require.Equal(t, "pets", renderTemplate(t, `{{ range $endpoint, $changes := .APIChanges }}{{ range $changes | tag "pets" }}{{ join "," .Tags }}{{ end }}{{ end }}`))
+}
+
+func TestTemplate_StringFuncs(t *testing.T) {
+	require.Equal(t, "ERROR info", renderTemplate(t, `{{ upper "error" }} {{ lower "INFO" }}`))
+}
+
+func TestTemplate_InvalidArgument(t *testing.T) {
+	_, err := markupFormatter.RenderChangelog(templateChanges, formatters.RenderOpts{Template: `{{ breaking .BaseVersion }}`}, nil)
+	require.ErrorContains(t, err, "expected changes, got string")
+}
+
+func TestTemplate_ParseError(t *testing.T) {
+	_, err := markupFormatter.RenderChangelog(templateChanges, formatters.RenderOpts{Template: `{{ .Changes `}, nil)
+	require.ErrorContains(t, err, "failed to parse template")
+
+	_, err = htmlFormatter.RenderChangelog(templateChanges, formatters.RenderOpts{Template: `{{ .Changes `}, nil)
+	require.ErrorContains(t, err, "failed to parse template")
+}
+
+func TestTemplate_Html(t *testing.T) {
+	out, err := htmlFormatter.RenderChangelog(templateChanges, formatters.RenderOpts{Template: `{{ range .Changes | level "error" }}<b>{{ .Path }}</b>{{ end }}`}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "<b>/users</b>", string(out))
+}
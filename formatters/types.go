@@ -15,6 +15,11 @@ const (
 	FormatGithubActions Format = "githubactions"
 	FormatJUnit         Format = "junit"
 	FormatSarif         Format = "sarif"
+	FormatGitLab        Format = "gitlab"
+	FormatAzure         Format = "azure"
+	FormatCheckstyle    Format = "checkstyle"
+	FormatTeamCity      Format = "teamcity"
+	FormatNDJSON        Format = "ndjson"
 )
 
 func GetSupportedFormats() []string {
@@ -29,6 +34,11 @@ func GetSupportedFormats() []string {
 		string(FormatGithubActions),
 		string(FormatJUnit),
 		string(FormatSarif),
+		string(FormatGitLab),
+		string(FormatAzure),
+		string(FormatCheckstyle),
+		string(FormatTeamCity),
+		string(FormatNDJSON),
 	}
 }
 
@@ -40,6 +50,10 @@ type FormatterOpts struct {
 // RenderOpts can be used to pass properties to the renderer method
 type RenderOpts struct {
 	ColorMode checker.ColorMode
+	Template  string // custom changelog template, overrides the built-in html and markdown templates
+
+	Interactive bool            // render the html diff as an interactive report
+	Changes     checker.Changes // changes to annotate the interactive html diff report with
 }
 
 func NewRenderOpts() RenderOpts {
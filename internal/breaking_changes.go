@@ -14,7 +14,7 @@ func getBreakingChangesCmd() *cobra.Command {
 		Short: "Display breaking changes",
 		Long:  "Display breaking changes between base and revision specs." + specHelp,
 		Args:  getParseArgs(),
-		RunE:  getRun(runBreakingChanges),
+		RunE:  getRunWithBaseAndRevision(runBreakingChanges),
 	}
 
 	addCommonDiffFlags(&cmd)
@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/bundle"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/spf13/cobra"
+)
+
+const bundleCmd = "bundle"
+
+func getBundleCmd() *cobra.Command {
+
+	cmd := cobra.Command{
+		Use:   "bundle spec [flags]",
+		Short: "Inline external references",
+		Long: `Display a self-contained version of the given OpenAPI spec by moving all external and remote references into components.
+Use --dereference to also replace the references under paths by the referenced components.
+Spec can be a path to a file, a URL or '-' to read standard input.
+Multi-file specs can be loaded from a directory or a zip, tar or tar.gz archive, followed by '#' and the path of the root spec.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: getRun(runBundle),
+	}
+
+	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputBundle), string(formatters.FormatYAML)), "format", "f", "output format")
+	cmd.PersistentFlags().Bool("dereference", false, "replace references under paths by the referenced components, except for circular references")
+	addLoadFlags(&cmd)
+
+	return &cmd
+}
+
+func runBundle(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
+
+	loader, returnErr := newLoader(flags)
+	if returnErr != nil {
+		return false, returnErr
+	}
+
+	spec, err := load.NewSpecInfo(loader, flags.getBase())
+	if err != nil {
+		return false, getErrFailedToLoadSpec("original", flags.getBase(), err)
+	}
+
+	bundled := bundle.Bundle(spec.Spec)
+	if flags.getDereference() {
+		bundled = bundle.Dereference(bundled)
+	}
+
+	if returnErr := outputBundledSpec(stdout, bundled, flags.getFormat()); returnErr != nil {
+		return false, returnErr
+	}
+
+	return false, nil
+}
+
+func outputBundledSpec(stdout io.Writer, spec *openapi3.T, format string) *ReturnError {
+	// formatter lookup
+	formatter, err := formatters.Lookup(format, formatters.DefaultFormatterOpts())
+	if err != nil {
+		return getErrUnsupportedFormat(format, bundleCmd)
+	}
+
+	// render
+	bytes, err := formatter.RenderBundle(spec, formatters.NewRenderOpts())
+	if err != nil {
+		return getErrFailedPrint("bundle "+format, err)
+	}
+
+	// print output
+	_, _ = fmt.Fprintf(stdout, "%s\n", bytes)
+
+	return nil
+}
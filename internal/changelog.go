@@ -3,6 +3,8 @@ package internal
 import (
 	"fmt"
 	"io"
+	"iter"
+	"os"
 
 	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/formatters"
@@ -19,7 +21,7 @@ func getChangelogCmd() *cobra.Command {
 		Short: "Display changelog",
 		Long:  "Display changes between base and revision specs." + specHelp,
 		Args:  getParseArgs(),
-		RunE:  getRun(runChangelog),
+		RunE:  getRunWithBaseAndRevision(runChangelog),
 	}
 
 	addCommonDiffFlags(&cmd)
@@ -46,19 +48,46 @@ func runChangelog(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
 
 func getChangelog(flags *Flags, stdout io.Writer, level checker.Level) (bool, *ReturnError) {
 
-	diffResult, returnErr := calcDiff(flags)
+	if flags.getStream() {
+		return streamChangelog(flags, stdout, level)
+	}
+
+	errs, specInfoPair, returnErr := calcChanges(flags, level)
 	if returnErr != nil {
 		return false, returnErr
 	}
 
-	severityLevels, returnErr := getCustomSeverityLevels(flags.getSeverityLevelsFile())
-	if returnErr != nil {
+	if returnErr := outputChangelog(flags, stdout, errs, specInfoPair); returnErr != nil {
 		return false, returnErr
 	}
 
+	if flags.getFailOn() != "" {
+		level, err := checker.NewLevel(flags.getFailOn())
+		if err != nil {
+			return false, getErrInvalidFlags(fmt.Errorf("invalid fail-on value %s", flags.getFailOn()))
+		}
+		return errs.HasLevelOrHigher(level), nil
+	}
+
+	return false, nil
+}
+
+// calcChanges runs the checks with level equal or higher than the given level and removes ignored changes
+func calcChanges(flags *Flags, level checker.Level) (checker.Changes, *load.SpecInfoPair, *ReturnError) {
+
+	diffResult, returnErr := calcDiff(flags)
+	if returnErr != nil {
+		return nil, nil, returnErr
+	}
+
+	config, returnErr := getCheckerConfig(flags)
+	if returnErr != nil {
+		return nil, nil, returnErr
+	}
+
 	errs, returnErr := filterIgnored(
 		checker.CheckBackwardCompatibilityUntilLevel(
-			checker.NewConfig(checker.GetAllChecks()).WithOptionalChecks(flags.getIncludeChecks()).WithSeverityLevels(severityLevels).WithDeprecation(flags.getDeprecationDaysBeta(), flags.getDeprecationDaysStable()).WithAttributes(flags.getAttributes()),
+			config,
 			diffResult.diffReport,
 			diffResult.operationsSources,
 			level),
@@ -67,22 +96,39 @@ func getChangelog(flags *Flags, stdout io.Writer, level checker.Level) (bool, *R
 		checker.NewLocalizer(flags.getLang()))
 
 	if returnErr != nil {
-		return false, returnErr
+		return nil, nil, returnErr
 	}
 
-	if returnErr := outputChangelog(flags, stdout, errs, diffResult.specInfoPair); returnErr != nil {
-		return false, returnErr
+	return errs, diffResult.specInfoPair, nil
+}
+
+// getCheckerConfig returns the checker config according to the flags
+func getCheckerConfig(flags *Flags) (*checker.Config, *ReturnError) {
+
+	severityLevels, returnErr := getCustomSeverityLevels(flags.getSeverityLevelsFile())
+	if returnErr != nil {
+		return nil, returnErr
 	}
 
-	if flags.getFailOn() != "" {
-		level, err := checker.NewLevel(flags.getFailOn())
-		if err != nil {
-			return false, getErrInvalidFlags(fmt.Errorf("invalid fail-on value %s", flags.getFailOn()))
-		}
-		return errs.HasLevelOrHigher(level), nil
+	stabilityPolicy, returnErr := getStabilityPolicy(flags.getStabilityPolicyFile())
+	if returnErr != nil {
+		return nil, returnErr
 	}
 
-	return false, nil
+	config, err := checker.NewConfig(checker.GetAllChecks()).WithDeprecation(flags.getDeprecationDaysBeta(), flags.getDeprecationDaysStable()).WithAttributes(flags.getAttributes()).WithOptionalChecks(flags.getIncludeChecks())
+	if err != nil {
+		return nil, getErrInvalidCheckerConfig(err)
+	}
+
+	if config, err = config.WithSeverityLevels(severityLevels); err != nil {
+		return nil, getErrInvalidCheckerConfig(err)
+	}
+
+	if config, err = config.WithStabilityPolicy(stabilityPolicy); err != nil {
+		return nil, getErrInvalidCheckerConfig(err)
+	}
+
+	return config, nil
 }
 
 func filterIgnored(errs checker.Changes, warnIgnoreFile string, errIgnoreFile string, l checker.Localizer) (checker.Changes, *ReturnError) {
@@ -106,6 +152,116 @@ func filterIgnored(errs checker.Changes, warnIgnoreFile string, errIgnoreFile st
 	return errs, nil
 }
 
+// streamChangelog writes the changes to stdout as the checks find them instead of collecting, sorting and rendering all of them at once
+func streamChangelog(flags *Flags, stdout io.Writer, level checker.Level) (bool, *ReturnError) {
+
+	var failOnLevel checker.Level
+	if flags.getFailOn() != "" {
+		var err error
+		failOnLevel, err = checker.NewLevel(flags.getFailOn())
+		if err != nil {
+			return false, getErrInvalidFlags(fmt.Errorf("invalid fail-on value %s", flags.getFailOn()))
+		}
+	}
+
+	// formatter lookup
+	formatter, err := formatters.Lookup(flags.getFormat(), formatters.FormatterOpts{
+		Language: flags.getLang(),
+	})
+	if err != nil {
+		return false, getErrUnsupportedFormat(flags.getFormat(), changelogCmd)
+	}
+
+	streamingFormatter, ok := formatter.(formatters.StreamingFormatter)
+	if !ok {
+		return false, getErrInvalidFlags(fmt.Errorf("--stream is only supported with json, ndjson and singleline formats, got %s", flags.getFormat()))
+	}
+
+	colorMode, err := checker.NewColorMode(flags.getColor())
+	if err != nil {
+		return false, getErrInvalidColorMode(err)
+	}
+
+	if _, returnErr := getTemplate(flags); returnErr != nil {
+		return false, returnErr
+	}
+
+	changes, returnErr := calcChangesSeq(flags, level)
+	if returnErr != nil {
+		return false, returnErr
+	}
+
+	failed := false
+	trackFailOn := func(yield func(checker.Change) bool) {
+		for change := range changes {
+			if flags.getFailOn() != "" && change.GetLevel() >= failOnLevel {
+				failed = true
+			}
+			if !yield(change) {
+				return
+			}
+		}
+	}
+
+	if err := streamingFormatter.StreamChangelog(stdout, trackFailOn, formatters.RenderOpts{ColorMode: colorMode}); err != nil {
+		return false, getErrFailedPrint(changelogCmd+" "+flags.getFormat(), err)
+	}
+	_, _ = fmt.Fprintln(stdout)
+
+	return failed, nil
+}
+
+// calcChangesSeq is like calcChanges but returns a sequence that runs the checks and removes ignored changes as it is iterated
+func calcChangesSeq(flags *Flags, level checker.Level) (iter.Seq[checker.Change], *ReturnError) {
+
+	diffResult, returnErr := calcDiff(flags)
+	if returnErr != nil {
+		return nil, returnErr
+	}
+
+	config, returnErr := getCheckerConfig(flags)
+	if returnErr != nil {
+		return nil, returnErr
+	}
+
+	warnIgnoreList, returnErr := getIgnoreList(checker.WARN, flags.getWarnIgnoreFile(), "warn")
+	if returnErr != nil {
+		return nil, returnErr
+	}
+
+	errIgnoreList, returnErr := getIgnoreList(checker.ERR, flags.getErrIgnoreFile(), "err")
+	if returnErr != nil {
+		return nil, returnErr
+	}
+
+	l := checker.NewLocalizer(flags.getLang())
+	changes := checker.CheckBackwardCompatibilityUntilLevelSeq(config, diffResult.diffReport, diffResult.operationsSources, level)
+
+	return func(yield func(checker.Change) bool) {
+		for change := range changes {
+			if warnIgnoreList.Match(change, l) || errIgnoreList.Match(change, l) {
+				continue
+			}
+			if !yield(change) {
+				return
+			}
+		}
+	}, nil
+}
+
+func getIgnoreList(level checker.Level, ignoreFile string, name string) (*checker.IgnoreList, *ReturnError) {
+	if ignoreFile == "" {
+		return nil, nil
+	}
+
+	ignoreList, err := checker.NewIgnoreList(level, ignoreFile)
+	if err != nil {
+		return nil, getErrCantProcessIgnoreFile(name, err)
+	}
+
+	return ignoreList, nil
+}
+
 func outputChangelog(flags *Flags, stdout io.Writer, errs checker.Changes, specInfoPair *load.SpecInfoPair) *ReturnError {
 
 	// formatter lookup
@@ -122,7 +278,12 @@ func outputChangelog(flags *Flags, stdout io.Writer, errs checker.Changes, specI
 		return getErrInvalidColorMode(err)
 	}
 
-	bytes, err := formatter.RenderChangelog(errs, formatters.RenderOpts{ColorMode: colorMode}, specInfoPair)
+	template, returnErr := getTemplate(flags)
+	if returnErr != nil {
+		return returnErr
+	}
+
+	bytes, err := formatter.RenderChangelog(errs, formatters.RenderOpts{ColorMode: colorMode, Template: template}, specInfoPair)
 	if err != nil {
 		return getErrFailedPrint(changelogCmd+" "+flags.getFormat(), err)
 	}
@@ -133,6 +294,26 @@ func outputChangelog(flags *Flags, stdout io.Writer, errs checker.Changes, specI
 	return nil
 }
 
+// getTemplate reads the custom template, templates are only supported by the template-based formats
+func getTemplate(flags *Flags) (string, *ReturnError) {
+	if flags.getTemplate() == "" {
+		return "", nil
+	}
+
+	switch formatters.Format(flags.getFormat()) {
+	case formatters.FormatHTML, formatters.FormatMarkdown, formatters.FormatMarkup:
+	default:
+		return "", getErrInvalidFlags(fmt.Errorf("--template is only supported with html, markdown and markup formats, got %s", flags.getFormat()))
+	}
+
+	content, err := os.ReadFile(flags.getTemplate())
+	if err != nil {
+		return "", getErrFailedToLoadTemplate(flags.getTemplate(), err)
+	}
+
+	return string(content), nil
+}
+
 func getCustomSeverityLevels(severityLevelsFile string) (map[string]checker.Level, *ReturnError) {
 	if severityLevelsFile == "" {
 		return nil, nil
@@ -145,3 +326,16 @@ func getCustomSeverityLevels(severityLevelsFile string) (map[string]checker.Leve
 
 	return m, nil
 }
+
+func getStabilityPolicy(stabilityPolicyFile string) (*checker.StabilityPolicy, *ReturnError) {
+	if stabilityPolicyFile == "" {
+		return nil, nil
+	}
+
+	policy, err := checker.ProcessStabilityPolicy(stabilityPolicyFile)
+	if err != nil {
+		return nil, getErrFailedToLoadStabilityPolicy(stabilityPolicyFile, err)
+	}
+
+	return policy, nil
+}
@@ -3,11 +3,13 @@ package internal
 import (
 	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/checker/localizations"
+	"github.com/oasdiff/oasdiff/diff"
 	"github.com/oasdiff/oasdiff/formatters"
 	"github.com/spf13/cobra"
 )
 
 func addCommonDiffFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("profile", "", "use the settings of this profile in the config file")
 	cmd.PersistentFlags().BoolP("composed", "c", false, "work in 'composed' mode, compare paths in all specs matching base and revision globs")
 	cmd.PersistentFlags().StringP("match-path", "p", "", "include only paths that match this regular expression")
 	cmd.PersistentFlags().StringP("unmatch-path", "q", "", "exclude paths that match this regular expression")
@@ -17,14 +19,33 @@ func addCommonDiffFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().String("strip-prefix-base", "", "strip this prefix from paths in base-spec before comparison")
 	cmd.PersistentFlags().String("strip-prefix-revision", "", "strip this prefix from paths in revised-spec before comparison")
 	cmd.PersistentFlags().Bool("include-path-params", false, "include path parameter names in endpoint matching")
+	cmd.PersistentFlags().String("path-renames", "", "configuration file mapping paths in base-spec to their new names in revised-spec")
+	enumWithOptions(cmd, newEnumValue(diff.GetMatchByOptions(), diff.MatchByPathOption), "match-by", "", "how to match operations in base-spec and revised-spec")
+	cmd.PersistentFlags().Bool("detect-property-renames", false, "compare removed and added properties with equal schemas as renamed properties")
+	cmd.PersistentFlags().Float64("schema-rename-similarity", diff.DefaultSchemaRenameSimilarity, "detect renamed component schemas: min similarity (0-1) between a deleted and an added schema to compare them as a renamed schema, 1 requires an identical structure, 0 disables rename detection")
+	cmd.PersistentFlags().Int("parallelism", diff.DefaultParallelism, "max number of paths to diff concurrently, useful for large specs")
 	cmd.PersistentFlags().Bool("flatten-allof", false, "merge subschemas under allOf before diff")
 	cmd.PersistentFlags().Bool("flatten-params", false, "merge common parameters at path level with operation parameters")
 	cmd.PersistentFlags().Bool("case-insensitive-headers", false, "case-insensitive header name comparison")
+	addLoadFlags(cmd)
 
 	addHiddenFlattenFlag(cmd)
 	addHiddenCircularDepFlag(cmd)
 }
 
+// addLoadFlags adds flags that control how specs and external references are loaded from archives, directories and http/s URLs
+func addLoadFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("entrypoint", "", "path of the root spec inside archives and directories, can also be specified as archive#path")
+	cmd.PersistentFlags().StringArray("http-header", nil, "add a header to requests for remote specs, formatted as 'host=Name: value', use '*' to match all hosts, environment variables in the value are expanded")
+	cmd.PersistentFlags().String("http-cert", "", "PEM encoded client certificate for mutual TLS")
+	cmd.PersistentFlags().String("http-key", "", "PEM encoded client key for mutual TLS")
+	cmd.PersistentFlags().String("http-ca-cert", "", "PEM encoded certificate authority to verify remote servers")
+	cmd.PersistentFlags().String("http-proxy", "", "proxy URL for remote specs, defaults to the HTTP_PROXY and HTTPS_PROXY environment variables")
+	cmd.PersistentFlags().Duration("http-timeout", 0, "timeout for each request for a remote spec, for example, 30s, zero means no timeout")
+	cmd.PersistentFlags().String("cache-dir", "", "cache remote specs in this directory and revalidate them with ETag and Last-Modified headers")
+	cmd.PersistentFlags().Bool("offline", false, "read remote specs from the cache directory only")
+}
+
 // addHiddenFlattenFlag adds --flatten as a hidden flag
 // --flatten was replaced by --flatten-allof
 // we still accept --flatten as a synonym for --flatten-allof to avoid breaking existing scripts
@@ -52,6 +73,16 @@ func hideFlag(cmd *cobra.Command, flag string) {
 }
 
 func addCommonBreakingFlags(cmd *cobra.Command) {
+	addCommonCheckerFlags(cmd)
+	enumWithOptions(cmd, newEnumValue(checker.GetSupportedColorValues(), "auto"), "color", "", "when to colorize textual output")
+	enumWithOptions(cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputChangelog), string(formatters.FormatText)), "format", "f", "output format")
+	cmd.PersistentFlags().StringSlice("attributes", nil, "OpenAPI Extensions to include in json or yaml output")
+	cmd.PersistentFlags().String("template", "", "custom go template file for html, markdown or markup output")
+	cmd.PersistentFlags().Bool("stream", false, "write changes as they are found to reduce memory usage on huge diffs (json, ndjson and singleline formats only)")
+}
+
+// addCommonCheckerFlags adds the flags that control how changes are detected and classified
+func addCommonCheckerFlags(cmd *cobra.Command) {
 	enumWithOptions(cmd, newEnumValue(localizations.GetSupportedLanguages(), localizations.LangDefault), "lang", "l", "language for localized output")
 	cmd.PersistentFlags().String("err-ignore", "", "configuration file for ignoring errors")
 	cmd.PersistentFlags().String("warn-ignore", "", "configuration file for ignoring warnings")
@@ -59,8 +90,6 @@ func addCommonBreakingFlags(cmd *cobra.Command) {
 	hideFlag(cmd, "include-checks")
 	cmd.PersistentFlags().Uint("deprecation-days-beta", checker.DefaultBetaDeprecationDays, "min days required between deprecating a beta resource and removing it")
 	cmd.PersistentFlags().Uint("deprecation-days-stable", checker.DefaultStableDeprecationDays, "min days required between deprecating a stable resource and removing it")
-	enumWithOptions(cmd, newEnumValue(checker.GetSupportedColorValues(), "auto"), "color", "", "when to colorize textual output")
-	enumWithOptions(cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputChangelog), string(formatters.FormatText)), "format", "f", "output format")
 	cmd.PersistentFlags().String("severity-levels", "", "configuration file for custom severity levels")
-	cmd.PersistentFlags().StringSlice("attributes", nil, "OpenAPI Extensions to include in json or yaml output")
+	cmd.PersistentFlags().String("stability-policy", "", "configuration file for assigning stability levels and deprecation days to endpoints")
 }
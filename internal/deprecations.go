@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/spf13/cobra"
+)
+
+const deprecationsCmd = "deprecations"
+
+func getDeprecationsCmd() *cobra.Command {
+
+	cmd := cobra.Command{
+		Use:   "deprecations spec [flags]",
+		Short: "Display deprecated resources",
+		Long: `Display all operations, parameters, properties and response headers which are marked as deprecated in the given OpenAPI spec, along with their stability level and sunset date.
+Deprecated resources with a missing, invalid or past due sunset date are flagged.
+Spec can be a path to a file, a URL or '-' to read standard input.
+Multi-file specs can be loaded from a directory or a zip, tar or tar.gz archive, followed by '#' and the path of the root spec.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: getRun(runDeprecations),
+	}
+
+	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputDeprecations), string(formatters.FormatText)), "format", "f", "output format")
+	cmd.PersistentFlags().Bool("fail-on-issues", false, "exit with return code 1 when a deprecated resource has a missing, invalid or past due sunset date")
+	addLoadFlags(&cmd)
+
+	return &cmd
+}
+
+func runDeprecations(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
+
+	loader, returnErr := newLoader(flags)
+	if returnErr != nil {
+		return false, returnErr
+	}
+
+	spec, err := load.NewSpecInfo(loader, flags.getBase())
+	if err != nil {
+		return false, getErrFailedToLoadSpec("original", flags.getBase(), err)
+	}
+
+	deprecations := checker.GetDeprecations(spec.Spec, civil.DateOf(time.Now()))
+
+	if returnErr := outputDeprecations(stdout, formatters.NewDeprecations(deprecations), flags.getFormat()); returnErr != nil {
+		return false, returnErr
+	}
+
+	return flags.getFailOnIssues() && len(deprecations.GetIssues()) > 0, nil
+}
+
+func outputDeprecations(stdout io.Writer, deprecations formatters.Deprecations, format string) *ReturnError {
+	// formatter lookup
+	formatter, err := formatters.Lookup(format, formatters.DefaultFormatterOpts())
+	if err != nil {
+		return getErrUnsupportedFormat(format, deprecationsCmd)
+	}
+
+	// render
+	bytes, err := formatter.RenderDeprecations(deprecations, formatters.NewRenderOpts())
+	if err != nil {
+		return getErrFailedPrint(deprecationsCmd+" "+format, err)
+	}
+
+	// print output
+	_, _ = fmt.Fprintf(stdout, "%s\n", bytes)
+
+	return nil
+}
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/diff"
 	"github.com/oasdiff/oasdiff/formatters"
 	"github.com/oasdiff/oasdiff/load"
@@ -20,13 +20,15 @@ func getDiffCmd() *cobra.Command {
 		Short: "Generate a diff report",
 		Long:  "Generate a diff report between base and revision specs." + specHelp,
 		Args:  getParseArgs(),
-		RunE:  getRun(runDiff),
+		RunE:  getRunWithBaseAndRevision(runDiff),
 	}
 
 	addCommonDiffFlags(&cmd)
 	enumWithOptions(&cmd, newEnumSliceValue(diff.GetExcludeDiffOptions(), nil), "exclude-elements", "e", "elements to exclude")
 	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputDiff), string(formatters.FormatYAML)), "format", "f", "output format")
 	cmd.PersistentFlags().BoolP("fail-on-diff", "o", false, "exit with return code 1 when any change is found")
+	cmd.PersistentFlags().Bool("interactive", false, "generate a standalone interactive html report with breaking change markers, requires --format html")
+	addCommonCheckerFlags(&cmd)
 
 	return &cmd
 }
@@ -37,27 +39,65 @@ func runDiff(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
 		flags.addExcludeElements(diff.ExcludeEndpointsOption)
 	}
 
+	if flags.getInteractive() && flags.getFormat() != string(formatters.FormatHTML) {
+		return false, getErrInvalidFlags(fmt.Errorf("--interactive is only supported with html format, got %s", flags.getFormat()))
+	}
+
 	diffResult, err := calcDiff(flags)
 	if err != nil {
 		return false, err
 	}
 
-	if err := outputDiff(stdout, diffResult.diffReport, flags.getFormat()); err != nil {
+	opts := formatters.NewRenderOpts()
+	if flags.getInteractive() {
+		opts.Interactive = true
+		if opts.Changes, err = getInteractiveChanges(flags, diffResult); err != nil {
+			return false, err
+		}
+	}
+
+	if err := outputDiff(stdout, diffResult.diffReport, flags.getFormat(), flags.getLang(), opts); err != nil {
 		return false, err
 	}
 
 	return flags.getFailOnDiff() && !diffResult.diffReport.Empty(), nil
 }
 
-func outputDiff(stdout io.Writer, diffReport *diff.Diff, format string) *ReturnError {
+// getInteractiveChanges returns the changes that are displayed as markers in the interactive report, according to the checker flags
+// the checker removes draft and alpha operations from the diff report that it checks, so it runs on a separate diff to leave the rendered report intact
+func getInteractiveChanges(flags *Flags, rendered *diffResult) (checker.Changes, *ReturnError) {
+	config, returnErr := getCheckerConfig(flags)
+	if returnErr != nil {
+		return nil, returnErr
+	}
+
+	diffResult, returnErr := rendered.recalc()
+	if returnErr != nil {
+		return nil, returnErr
+	}
+
+	return filterIgnored(
+		checker.CheckBackwardCompatibilityUntilLevel(
+			config,
+			diffResult.diffReport,
+			diffResult.operationsSources,
+			checker.INFO),
+		flags.getWarnIgnoreFile(),
+		flags.getErrIgnoreFile(),
+		checker.NewLocalizer(flags.getLang()))
+}
+
+func outputDiff(stdout io.Writer, diffReport *diff.Diff, format string, lang string, opts formatters.RenderOpts) *ReturnError {
 	// formatter lookup
-	formatter, err := formatters.Lookup(format, formatters.DefaultFormatterOpts())
+	formatter, err := formatters.Lookup(format, formatters.FormatterOpts{
+		Language: lang,
+	})
 	if err != nil {
 		return getErrUnsupportedFormat(format, diffCmd)
 	}
 
 	// render
-	bytes, err := formatter.RenderDiff(diffReport, formatters.NewRenderOpts())
+	bytes, err := formatter.RenderDiff(diffReport, opts)
 	if err != nil {
 		return getErrFailedPrint("diff "+format, err)
 	}
@@ -70,8 +110,10 @@ func outputDiff(stdout io.Writer, diffReport *diff.Diff, format string) *ReturnE
 
 func calcDiff(flags *Flags) (*diffResult, *ReturnError) {
 
-	loader := openapi3.NewLoader()
-	loader.IsExternalRefsAllowed = true
+	loader, returnErr := newLoader(flags)
+	if returnErr != nil {
+		return nil, returnErr
+	}
 
 	if flags.getComposed() {
 		return composedDiff(loader, flags)
@@ -84,14 +126,28 @@ type diffResult struct {
 	diffReport        *diff.Diff
 	operationsSources *diff.OperationsSourcesMap
 	specInfoPair      *load.SpecInfoPair
+	getDiff           getDiffFunc // calculates the diff of the loaded specs
 }
 
-func newDiffResult(d *diff.Diff, o *diff.OperationsSourcesMap, s *load.SpecInfoPair) *diffResult {
+type getDiffFunc func() (*diff.Diff, *diff.OperationsSourcesMap, error)
+
+func newDiffResult(getDiff getDiffFunc, s *load.SpecInfoPair) (*diffResult, *ReturnError) {
+	diffReport, operationsSources, err := getDiff()
+	if err != nil {
+		return nil, getErrDiffFailed(err)
+	}
+
 	return &diffResult{
-		diffReport:        d,
-		operationsSources: o,
+		diffReport:        diffReport,
+		operationsSources: operationsSources,
 		specInfoPair:      s,
-	}
+		getDiff:           getDiff,
+	}, nil
+}
+
+// recalc returns a separate diff of the same specs, without loading them again, for callers that modify the diff report, like the checker
+func (result *diffResult) recalc() (*diffResult, *ReturnError) {
+	return newDiffResult(result.getDiff, result.specInfoPair)
 }
 
 func normalDiff(loader load.Loader, flags *Flags) (*diffResult, *ReturnError) {
@@ -115,12 +171,14 @@ func normalDiff(loader load.Loader, flags *Flags) (*diffResult, *ReturnError) {
 		s2.Spec = s1.Spec
 	}
 
-	diffReport, operationsSources, err := diff.GetWithOperationsSourcesMap(flags.toConfig(), s1, s2)
-	if err != nil {
-		return nil, getErrDiffFailed(err)
+	config, returnErr := getDiffConfig(flags)
+	if returnErr != nil {
+		return nil, returnErr
 	}
 
-	return newDiffResult(diffReport, operationsSources, load.NewSpecInfoPair(s1, s2)), nil
+	return newDiffResult(func() (*diff.Diff, *diff.OperationsSourcesMap, error) {
+		return diff.GetWithOperationsSourcesMap(config, s1, s2)
+	}, load.NewSpecInfoPair(s1, s2))
 }
 
 func composedDiff(loader load.Loader, flags *Flags) (*diffResult, *ReturnError) {
@@ -139,10 +197,38 @@ func composedDiff(loader load.Loader, flags *Flags) (*diffResult, *ReturnError)
 		return nil, getErrFailedToLoadSpecs("revision", flags.getRevision().Path, err)
 	}
 
-	diffReport, operationsSources, err := diff.GetPathsDiff(flags.toConfig(), s1, s2)
+	config, returnErr := getDiffConfig(flags)
+	if returnErr != nil {
+		return nil, returnErr
+	}
+
+	return newDiffResult(func() (*diff.Diff, *diff.OperationsSourcesMap, error) {
+		return diff.GetPathsDiff(config, s1, s2)
+	}, nil)
+}
+
+// getDiffConfig returns the diff config including the path renames which are loaded from a file
+func getDiffConfig(flags *Flags) (*diff.Config, *ReturnError) {
+	config := flags.toConfig()
+
+	if config.SchemaRenameSimilarity < 0 || config.SchemaRenameSimilarity > 1 {
+		return nil, getErrInvalidFlags(fmt.Errorf("invalid schema-rename-similarity %g, must be between 0 and 1", config.SchemaRenameSimilarity))
+	}
+
+	if config.Parallelism < 1 {
+		return nil, getErrInvalidFlags(fmt.Errorf("invalid parallelism %d, must be at least 1", config.Parallelism))
+	}
+
+	pathRenamesFile := flags.getPathRenamesFile()
+	if pathRenamesFile == "" {
+		return config, nil
+	}
+
+	pathRenames, err := diff.ProcessPathRenames(pathRenamesFile)
 	if err != nil {
-		return nil, getErrDiffFailed(err)
+		return nil, getErrFailedToLoadPathRenames(pathRenamesFile, err)
 	}
+	config.PathRenames = pathRenames
 
-	return newDiffResult(diffReport, operationsSources, nil), nil
+	return config, nil
 }
@@ -83,6 +83,48 @@ func getErrCantProcessIgnoreFile(what string, err error) *ReturnError {
 	)
 }
 
+func getErrInvalidCheckerConfig(err error) *ReturnError {
+	return getError(
+		fmt.Errorf("invalid checker config: %w", err),
+		122,
+	)
+}
+
+func getErrFailedToLoadStabilityPolicy(source string, err error) *ReturnError {
+	return getError(
+		fmt.Errorf("failed to load stability policy from %s: %w", source, err),
+		123,
+	)
+}
+
+func getErrInvalidRemoteOptions(err error) *ReturnError {
+	return getError(
+		fmt.Errorf("invalid remote loading options: %w", err),
+		124,
+	)
+}
+
+func getErrFailedToLoadTemplate(source string, err error) *ReturnError {
+	return getError(
+		fmt.Errorf("failed to load template from %s: %w", source, err),
+		125,
+	)
+}
+
+func getErrFailedToLoadPathRenames(source string, err error) *ReturnError {
+	return getError(
+		fmt.Errorf("failed to load path renames from %s: %w", source, err),
+		126,
+	)
+}
+
+func getErrFailedToLoadManifest(source string, err error) *ReturnError {
+	return getError(
+		fmt.Errorf("failed to load manifest from %s: %w", source, err),
+		127,
+	)
+}
+
 func getError(err error, code int) *ReturnError {
 	return &ReturnError{err, code}
 }
@@ -28,6 +28,10 @@ func (flags *Flags) toConfig() *diff.Config {
 	config.PathStripPrefixBase = flags.v.GetString("strip-prefix-base")
 	config.PathStripPrefixRevision = flags.v.GetString("strip-prefix-revision")
 	config.IncludePathParams = flags.v.GetBool("include-path-params")
+	config.MatchBy = flags.v.GetString("match-by")
+	config.SchemaRenameSimilarity = flags.v.GetFloat64("schema-rename-similarity")
+	config.DetectPropertyRenames = flags.v.GetBool("detect-property-renames")
+	config.Parallelism = flags.v.GetInt("parallelism")
 
 	return config
 }
@@ -108,10 +112,55 @@ func (flags *Flags) getFailOnDiff() bool {
 	return flags.v.GetBool("fail-on-diff")
 }
 
+func (flags *Flags) getFailOnIssues() bool {
+	return flags.v.GetBool("fail-on-issues")
+}
+
 func (flags *Flags) getSeverityLevelsFile() string {
 	return flags.v.GetString("severity-levels")
 }
 
+func (flags *Flags) getStabilityPolicyFile() string {
+	return flags.v.GetString("stability-policy")
+}
+
+func (flags *Flags) getPathRenamesFile() string {
+	return flags.v.GetString("path-renames")
+}
+
+func (flags *Flags) getTemplate() string {
+	return flags.v.GetString("template")
+}
+
+func (flags *Flags) getStream() bool {
+	return flags.v.GetBool("stream")
+}
+
+func (flags *Flags) getInteractive() bool {
+	return flags.v.GetBool("interactive")
+}
+
+func (flags *Flags) getDereference() bool {
+	return flags.v.GetBool("dereference")
+}
+
+func (flags *Flags) getEntrypoint() string {
+	return flags.v.GetString("entrypoint")
+}
+
+func (flags *Flags) getRemoteOptions() load.RemoteOptions {
+	return load.RemoteOptions{
+		Headers:  flags.v.GetStringSlice("http-header"),
+		CertFile: flags.v.GetString("http-cert"),
+		KeyFile:  flags.v.GetString("http-key"),
+		CAFile:   flags.v.GetString("http-ca-cert"),
+		Proxy:    flags.v.GetString("http-proxy"),
+		CacheDir: flags.v.GetString("cache-dir"),
+		Offline:  flags.v.GetBool("offline"),
+		Timeout:  flags.v.GetDuration("http-timeout"),
+	}
+}
+
 func (flags *Flags) getExcludeElements() []string {
 	return fixViperStringSlice(flags.v.GetStringSlice("exclude-elements"))
 }
@@ -135,3 +184,17 @@ func (flags *Flags) getSeverity() []string {
 func (flags *Flags) getTags() []string {
 	return fixViperStringSlice(flags.v.GetStringSlice("tags"))
 }
+
+// getConfigBase returns the base spec from the config file, it is used when base and revision aren't specified as arguments
+func (flags *Flags) getConfigBase() string {
+	return flags.v.GetString("base")
+}
+
+// getConfigRevision returns the revision spec from the config file, it is used when base and revision aren't specified as arguments
+func (flags *Flags) getConfigRevision() string {
+	return flags.v.GetString("revision")
+}
+
+func (flags *Flags) getConcurrency() int {
+	return flags.v.GetInt("concurrency")
+}
@@ -19,12 +19,14 @@ func getFlattenCmd() *cobra.Command {
 		Short: "Merge allOf",
 		Long: `Display a flattened version of the given OpenAPI spec by merging all instances of allOf.
 Spec can be a path to a file, a URL or '-' to read standard input.
+Multi-file specs can be loaded from a directory or a zip, tar or tar.gz archive, followed by '#' and the path of the root spec.
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: getRun(runFlatten),
 	}
 
 	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputFlatten), string(formatters.FormatJSON)), "format", "f", "output format")
+	addLoadFlags(&cmd)
 	addHiddenCircularDepFlag(&cmd)
 
 	return &cmd
@@ -32,8 +34,11 @@ Spec can be a path to a file, a URL or '-' to read standard input.
 
 func runFlatten(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
 
-	loader := openapi3.NewLoader()
-	loader.IsExternalRefsAllowed = true
+	loader, returnErr := newLoader(flags)
+	if returnErr != nil {
+		return false, returnErr
+	}
+
 	spec, err := load.NewSpecInfo(loader, flags.getBase(), load.WithFlattenAllOf())
 	if err != nil {
 		return false, getErrFailedToLoadSpec("original", flags.getBase(), err)
@@ -10,17 +10,27 @@ import (
 
 const specHelp = `
 Base and revision can be a path to a file, a URL, or '-' to read standard input.
-In 'composed' mode, base and revision can be a glob and oasdiff will compare matching endpoints between the two sets of files.`
+Multi-file specs can be loaded from a directory or a zip, tar or tar.gz archive, followed by '#' and the path of the root spec, for example: bundle.zip#openapi/root.yaml.
+In 'composed' mode, base and revision can be a glob and oasdiff will compare matching endpoints between the two sets of files.
+If base and revision are omitted, they are taken from the config file or from the profile selected with --profile.`
 
 func getParseArgs() cobra.PositionalArgs {
 	return func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			// base and revision may be specified in the config file, see getRun
+			return checkColor(cmd)
+		}
 		if len(args) < 2 {
-			return errors.New("please specify base and revision arguments as a path to a file, a glob (in composed mode), a URL, or '-' to read standard input")
+			return errMissingBaseAndRevision
 		}
 		if len(args) > 2 {
 			return errors.New("invalid arguments after base and revision")
 		}
-		if err := checkStdinWithComposed(cmd, args); err != nil {
+		composed, err := cmd.Flags().GetBool("composed")
+		if err != nil {
+			return errors.New("failed to get composed flag")
+		}
+		if err := checkStdinWithComposed(composed, args[0], args[1]); err != nil {
 			return err
 		}
 		if err := checkColor(cmd); err != nil {
@@ -31,9 +41,20 @@ func getParseArgs() cobra.PositionalArgs {
 	}
 }
 
+var errMissingBaseAndRevision = errors.New("please specify base and revision arguments as a path to a file, a glob (in composed mode), a URL, or '-' to read standard input")
+
 type runner func(flags *Flags, stdout io.Writer) (bool, *ReturnError)
 
 func getRun(runner runner) cobra.PositionalArgs {
+	return getRunInternal(runner, false)
+}
+
+// getRunWithBaseAndRevision is like getRun for commands that compare base and revision, which can also be specified in the config file
+func getRunWithBaseAndRevision(runner runner) cobra.PositionalArgs {
+	return getRunInternal(runner, true)
+}
+
+func getRunInternal(runner runner, withBaseAndRevision bool) cobra.PositionalArgs {
 	return func(cmd *cobra.Command, args []string) error {
 
 		flags := NewFlags()
@@ -44,11 +65,21 @@ func getRun(runner runner) cobra.PositionalArgs {
 		}
 
 		if len(args) > 0 {
-			flags.setBase(load.NewSource(args[0]))
+			flags.setBase(load.NewSource(args[0]).WithEntrypoint(flags.getEntrypoint()))
 		}
 
 		if len(args) > 1 {
-			flags.setRevision(load.NewSource(args[1]))
+			flags.setRevision(load.NewSource(args[1]).WithEntrypoint(flags.getEntrypoint()))
+		}
+
+		if len(args) == 0 && withBaseAndRevision {
+			base, revision := flags.getConfigBase(), flags.getConfigRevision()
+			if err := checkConfigBaseAndRevision(flags.getComposed(), base, revision); err != nil {
+				setReturnValue(cmd, err.Code)
+				return err
+			}
+			flags.setBase(load.NewSource(base).WithEntrypoint(flags.getEntrypoint()))
+			flags.setRevision(load.NewSource(revision).WithEntrypoint(flags.getEntrypoint()))
 		}
 
 		// by now flags have been parsed successfully so we don't need to show usage on any errors
@@ -81,18 +112,26 @@ func checkColor(cmd *cobra.Command) error {
 	return errors.New(`--color flag is only relevant with 'text' or 'singleline' formats`)
 }
 
-func checkStdinWithComposed(cmd *cobra.Command, args []string) error {
+// checkConfigBaseAndRevision applies the validations of base and revision arguments to base and revision taken from the config file
+func checkConfigBaseAndRevision(composed bool, base, revision string) *ReturnError {
+	if base == "" || revision == "" {
+		return getErrInvalidFlags(errMissingBaseAndRevision)
+	}
 
-	composed, err := cmd.Flags().GetBool("composed")
-	if err != nil {
-		return errors.New("failed to get composed flag")
+	if err := checkStdinWithComposed(composed, base, revision); err != nil {
+		return getErrInvalidFlags(err)
 	}
 
+	return nil
+}
+
+func checkStdinWithComposed(composed bool, base, revision string) error {
+
 	if !composed {
 		return nil
 	}
 
-	if args[0] == "-" || args[1] == "-" {
+	if base == "-" || revision == "-" {
 		return errors.New("can't read from stdin in composed mode")
 	}
 
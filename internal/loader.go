@@ -0,0 +1,15 @@
+package internal
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// newLoader returns an OpenAPI loader which reads remote specs according to the http flags
+func newLoader(flags *Flags) (*openapi3.Loader, *ReturnError) {
+	loader, err := load.NewRemoteLoader(flags.getRemoteOptions())
+	if err != nil {
+		return nil, getErrInvalidRemoteOptions(err)
+	}
+	return loader, nil
+}
@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+
+	"github.com/spf13/cobra"
+)
+
+// profiler writes CPU and memory profiles of a command, so that users can attach them to performance reports
+// the profiles can be viewed with 'go tool pprof'
+type profiler struct {
+	cpuFile    *os.File
+	memProfile string
+}
+
+// addHiddenProfilingFlags adds --profile-cpu and --profile-mem as hidden flags to all commands
+// these flags are intended for troubleshooting performance issues so we don't show them in the help
+func addHiddenProfilingFlags(rootCmd *cobra.Command, p *profiler) {
+	rootCmd.PersistentFlags().String("profile-cpu", "", "write a CPU profile to this file")
+	rootCmd.PersistentFlags().String("profile-mem", "", "write a memory profile to this file when the command ends")
+	hideFlag(rootCmd, "profile-cpu")
+	hideFlag(rootCmd, "profile-mem")
+
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		return p.start(cmd)
+	}
+}
+
+func (p *profiler) start(cmd *cobra.Command) error {
+	cpuProfile, err := cmd.Flags().GetString("profile-cpu")
+	if err != nil {
+		return err
+	}
+
+	p.memProfile, err = cmd.Flags().GetString("profile-mem")
+	if err != nil {
+		return err
+	}
+
+	if cpuProfile == "" {
+		return nil
+	}
+
+	p.cpuFile, err = os.Create(cpuProfile)
+	if err != nil {
+		return fmt.Errorf("failed to create CPU profile: %w", err)
+	}
+
+	if err := pprof.StartCPUProfile(p.cpuFile); err != nil {
+		_ = p.cpuFile.Close()
+		p.cpuFile = nil
+		return fmt.Errorf("failed to start CPU profile: %w", err)
+	}
+
+	return nil
+}
+
+// stop writes the profiles, it is called after the command ends, whether or not it succeeded
+func (p *profiler) stop() error {
+	var result error
+
+	if p.cpuFile != nil {
+		pprof.StopCPUProfile()
+		result = errors.Join(result, p.cpuFile.Close())
+	}
+
+	if p.memProfile != "" {
+		result = errors.Join(result, writeMemProfile(p.memProfile))
+	}
+
+	return result
+}
+
+func writeMemProfile(memProfile string) error {
+	file, err := os.Create(memProfile)
+	if err != nil {
+		return fmt.Errorf("failed to create memory profile: %w", err)
+	}
+	defer file.Close()
+
+	// get up-to-date statistics
+	runtime.GC()
+
+	if err := pprof.WriteHeapProfile(file); err != nil {
+		return fmt.Errorf("failed to write memory profile: %w", err)
+	}
+
+	return nil
+}
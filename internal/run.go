@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"fmt"
 	"io"
 	"strconv"
 
@@ -26,11 +27,26 @@ func Run(args []string, stdout io.Writer, stderr io.Writer) int {
 		getBreakingChangesCmd(),
 		getChangelogCmd(),
 		getFlattenCmd(),
+		getBundleCmd(),
 		getChecksCmd(),
+		getSemverCmd(),
+		getDeprecationsCmd(),
+		getRunAllCmd(),
+		getSchemaCmd(),
 		getQRCodeCmd(),
 	)
 
-	return run(rootCmd)
+	var p profiler
+	addHiddenProfilingFlags(rootCmd, &p)
+
+	code := run(rootCmd)
+
+	if err := p.stop(); err != nil {
+		_, _ = fmt.Fprintf(stderr, "Error: %v\n", err)
+		return max(code, generalExecutionErr)
+	}
+
+	return code
 }
 
 func setReturnValue(cmd *cobra.Command, code int) {
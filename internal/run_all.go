@@ -0,0 +1,256 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/checker/localizations"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+const runAllCmd = "run-all"
+
+// runAllReservedFlags are controlled by the run-all command and can't be set per API
+var runAllReservedFlags = []string{"format", "color", "template", "stream"}
+
+// manifest lists the APIs checked by the run-all command
+type manifest struct {
+	APIs []manifestEntry `yaml:"apis"`
+}
+
+// manifestEntry describes a single API in the manifest, flags are the flags of the breaking command by name
+type manifestEntry struct {
+	Name     string         `yaml:"name"`
+	Base     string         `yaml:"base"`
+	Revision string         `yaml:"revision"`
+	Flags    map[string]any `yaml:"flags"`
+}
+
+func getRunAllCmd() *cobra.Command {
+
+	cmd := cobra.Command{
+		Use:   "run-all manifest [flags]",
+		Short: "Display breaking changes of multiple APIs",
+		Long: `Display breaking changes of all APIs listed in a manifest file in a single report.
+Each API in the manifest has a base and a revision spec and optional flags of the breaking command, for example:
+apis:
+  - name: payments
+    base: specs/payments/base.yaml
+    revision: specs/payments/revision.yaml
+    flags:
+      match-path: ^/payments
+      err-ignore: payments-ignore.txt
+APIs are checked concurrently. The exit code is the highest exit code of all APIs.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: getRun(runRunAll),
+	}
+
+	enumWithOptions(&cmd, newEnumValue(formatters.GetRunAllFormats(), string(formatters.FormatText)), "format", "f", "output format")
+	enumWithOptions(&cmd, newEnumValue(GetBreakingLevels(), ""), "fail-on", "o", "exit with return code 1 when output includes errors with this level or higher, unless the API sets its own fail-on flag")
+	enumWithOptions(&cmd, newEnumValue(localizations.GetSupportedLanguages(), localizations.LangDefault), "lang", "l", "language for localized output")
+	enumWithOptions(&cmd, newEnumValue(checker.GetSupportedColorValues(), "auto"), "color", "", "when to colorize textual output")
+	cmd.PersistentFlags().Int("concurrency", runtime.NumCPU(), "max number of APIs to check concurrently")
+
+	return &cmd
+}
+
+func runRunAll(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
+
+	if !slices.Contains(formatters.GetRunAllFormats(), flags.getFormat()) {
+		return false, getErrUnsupportedFormat(flags.getFormat(), runAllCmd)
+	}
+
+	colorMode, err := checker.NewColorMode(flags.getColor())
+	if err != nil {
+		return false, getErrInvalidColorMode(err)
+	}
+
+	manifestFile := flags.getBase().Path
+	m, err := readManifest(manifestFile)
+	if err != nil {
+		return false, getErrFailedToLoadManifest(manifestFile, err)
+	}
+
+	results := checkAPIs(m.APIs, flags.getFailOn(), flags.getConcurrency())
+
+	output, err := formatters.RenderRunAll(flags.getFormat(), results, formatters.FormatterOpts{Language: flags.getLang()}, formatters.RenderOpts{ColorMode: colorMode})
+	if err != nil {
+		return false, getErrFailedPrint(runAllCmd+" "+flags.getFormat(), err)
+	}
+	_, _ = fmt.Fprintf(stdout, "%s\n", output)
+
+	exitCode := formatters.GetRunAllExitCode(results)
+	if exitCode > 1 {
+		return false, getError(fmt.Errorf("failed to check %d of %d APIs", countFailedAPIs(results), len(results)), exitCode)
+	}
+
+	return exitCode == 1, nil
+}
+
+func readManifest(file string) (*manifest, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var result manifest
+	if err := yaml.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	if len(result.APIs) == 0 {
+		return nil, errors.New("manifest doesn't list any apis")
+	}
+
+	return &result, nil
+}
+
+// checkAPIs checks the APIs concurrently and returns their results in the order of the manifest
+func checkAPIs(entries []manifestEntry, failOn string, concurrency int) []formatters.APIResult {
+	results := make([]formatters.APIResult, len(entries))
+
+	semaphore := make(chan struct{}, max(concurrency, 1))
+	var wg sync.WaitGroup
+	for i, entry := range entries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			results[i] = checkAPI(entry, failOn)
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// checkAPI runs the breaking command on a single API of the manifest
+func checkAPI(entry manifestEntry, failOn string) formatters.APIResult {
+	result := formatters.APIResult{
+		Name:     entry.Name,
+		Base:     entry.Base,
+		Revision: entry.Revision,
+	}
+
+	flags, returnErr := getManifestEntryFlags(entry, failOn)
+	if returnErr != nil {
+		return withError(result, returnErr)
+	}
+
+	if result.Base == "" {
+		result.Base = flags.getConfigBase()
+	}
+	if result.Revision == "" {
+		result.Revision = flags.getConfigRevision()
+	}
+	if result.Name == "" {
+		result.Name = result.Base
+	}
+	if result.Base == "" || result.Revision == "" {
+		return withError(result, getError(errors.New("base and revision must be specified in the manifest or in the config file"), generalExecutionErr))
+	}
+	if result.Base == "-" || result.Revision == "-" {
+		return withError(result, getError(errors.New("can't read from stdin in run-all"), generalExecutionErr))
+	}
+
+	flags.setBase(load.NewSource(result.Base).WithEntrypoint(flags.getEntrypoint()))
+	flags.setRevision(load.NewSource(result.Revision).WithEntrypoint(flags.getEntrypoint()))
+
+	changes, specInfoPair, returnErr := calcChanges(flags, checker.WARN)
+	if returnErr != nil {
+		return withError(result, returnErr)
+	}
+	result.Changes = changes
+	result.SpecInfoPair = specInfoPair
+
+	if flags.getFailOn() != "" {
+		level, err := checker.NewLevel(flags.getFailOn())
+		if err != nil {
+			return withError(result, getErrInvalidFlags(fmt.Errorf("invalid fail-on value %s", flags.getFailOn())))
+		}
+		if changes.HasLevelOrHigher(level) {
+			result.ExitCode = 1
+		}
+	}
+
+	return result
+}
+
+// getManifestEntryFlags parses the flags of a manifest entry with the flags of the breaking command, including the config file
+func getManifestEntryFlags(entry manifestEntry, failOn string) (*Flags, *ReturnError) {
+	args, err := getManifestEntryArgs(entry.Flags)
+	if err != nil {
+		return nil, getError(err, generalExecutionErr)
+	}
+
+	if _, ok := entry.Flags["fail-on"]; !ok && failOn != "" {
+		args = append(args, "--fail-on="+failOn)
+	}
+
+	cmd := getBreakingChangesCmd()
+	if err := cmd.ParseFlags(args); err != nil {
+		return nil, getError(err, generalExecutionErr)
+	}
+
+	flags := NewFlags()
+	if returnErr := RunViper(cmd, flags.getViper()); returnErr != nil {
+		return nil, returnErr
+	}
+
+	return flags, nil
+}
+
+// getManifestEntryArgs converts the flags of a manifest entry to command-line arguments
+func getManifestEntryArgs(flags map[string]any) ([]string, error) {
+	names := make([]string, 0, len(flags))
+	for name := range flags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := []string{}
+	for _, name := range names {
+		if slices.Contains(runAllReservedFlags, name) {
+			return nil, fmt.Errorf("flag %q can't be set per api", name)
+		}
+
+		switch value := flags[name].(type) {
+		case []any:
+			for _, item := range value {
+				result = append(result, fmt.Sprintf("--%s=%v", name, item))
+			}
+		default:
+			result = append(result, fmt.Sprintf("--%s=%v", name, value))
+		}
+	}
+
+	return result, nil
+}
+
+func withError(result formatters.APIResult, returnErr *ReturnError) formatters.APIResult {
+	result.Error = returnErr.error
+	result.ExitCode = returnErr.Code
+	return result
+}
+
+func countFailedAPIs(results []formatters.APIResult) int {
+	result := 0
+	for _, apiResult := range results {
+		if apiResult.Error != nil {
+			result++
+		}
+	}
+	return result
+}
@@ -3,10 +3,15 @@ package internal_test
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/formatters"
@@ -62,6 +67,53 @@ func Test_DiffHtml(t *testing.T) {
 	require.Contains(t, stdout.String(), `<h3 id="new-endpoints-none">New Endpoints: None</h3>`)
 }
 
+func Test_DiffHtmlInteractive(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test3.yaml -f html --interactive"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), `<span class="badge breaking">breaking</span>`)
+	require.Contains(t, stdout.String(), `<span class="rule-id">response-success-status-removed</span>`)
+}
+
+func Test_DiffHtmlInteractiveSeverityLevels(t *testing.T) {
+	severityLevels := filepath.Join(t.TempDir(), "severity-levels.txt")
+	require.NoError(t, os.WriteFile(severityLevels, []byte("response-success-status-removed info\n"), 0o644))
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test3.yaml -f html --interactive --severity-levels "+severityLevels), &stdout, io.Discard))
+	require.Regexp(t, `class="annotation level-info">[^<]*<span class="rule-id">response-success-status-removed</span>`, stdout.String())
+	require.NotRegexp(t, `class="annotation level-error">[^<]*<span class="rule-id">response-success-status-removed</span>`, stdout.String())
+}
+
+func Test_DiffHtmlInteractiveDraftOperationRemoved(t *testing.T) {
+	revision := filepath.Join(t.TempDir(), "revision.yaml")
+	require.NoError(t, os.WriteFile(revision, []byte(`
+info:
+  title: Tufin
+  version: 1.0.0
+openapi: 3.0.3
+paths:
+  /api/test:
+    post:
+      responses:
+        201:
+          description: OK
+`), 0o644))
+	args := "oasdiff diff ../data/deprecation/base-draft-stability.yaml " + revision + " --fail-on-diff -f html"
+
+	require.Equal(t, 1, internal.Run(cmdToArgs(args), io.Discard, io.Discard))
+
+	// the checker ignores the removal of draft operations, but the diff report still includes it
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs(args+" --interactive"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), `<details class="node deleted" data-levels="" data-tags="">
+    <summary><span class="name">GET /api/test</span></summary>`)
+	require.NotContains(t, stdout.String(), `class="annotation`)
+}
+
+func Test_DiffInteractiveInvalidFormat(t *testing.T) {
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test3.yaml -f yaml --interactive"), io.Discard, io.Discard))
+}
+
 func Test_DiffText(t *testing.T) {
 	var stdout bytes.Buffer
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test3.yaml -f text"), &stdout, io.Discard))
@@ -185,6 +237,64 @@ func Test_BreakingChangesIgnoreErrsApiSchemaOptional(t *testing.T) {
 	require.Len(t, bc, 4)
 }
 
+func Test_BreakingChangesStream(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format json"), &stdout, io.Discard))
+	expected := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &expected))
+
+	stdout.Reset()
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format json --stream"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.ElementsMatch(t, expected, bc)
+}
+
+func Test_BreakingChangesStreamIgnoreErrsAndWarns(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --err-ignore ../data/ignore-err-example.txt --warn-ignore ../data/ignore-warn-example.txt --format json --stream"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 4)
+}
+
+func Test_BreakingChangesStreamInvalidIgnoreFile(t *testing.T) {
+	require.Equal(t, 121, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --err-ignore no-such-file.txt --format json --stream"), io.Discard, io.Discard))
+}
+
+func Test_ChangelogStreamNDJSON(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format ndjson --stream"), &stdout, io.Discard))
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	require.NotEmpty(t, lines)
+	for _, line := range lines {
+		var change formatters.Change
+		require.NoError(t, json.Unmarshal([]byte(line), &change))
+		require.NotEmpty(t, change.Id)
+	}
+}
+
+func Test_ChangelogStreamSingleLine(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format singleline --stream"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "[response-success-status-removed]")
+	require.NotContains(t, stdout.String(), "changes:")
+}
+
+func Test_ChangelogStreamFailOn(t *testing.T) {
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format ndjson --stream --fail-on ERR"), io.Discard, io.Discard))
+}
+
+func Test_ChangelogStreamFailOnWarnInfosOnly(t *testing.T) {
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/simple3.yaml ../data/simple4.yaml --format ndjson --stream --fail-on WARN"), io.Discard, io.Discard))
+}
+
+func Test_ChangelogStreamInvalidFormat(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format html --stream"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "--stream is only supported with json, ndjson and singleline formats")
+}
+
 func Test_BreakingChangesInvalidIgnoreFile(t *testing.T) {
 	require.Equal(t, 121, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --err-ignore no-file"), io.Discard, io.Discard))
 }
@@ -194,7 +304,7 @@ func Test_ComposedMode(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff diff ../data/composed/base/*.yaml ../data/composed/revision/*.yaml --composed --exclude-elements endpoints,extensions"), &stdout, io.Discard))
 	var bc interface{}
 	require.NoError(t, yaml.Unmarshal(stdout.Bytes(), &bc))
-	require.Equal(t, map[string]interface{}{"paths": map[string]interface{}{"deleted": []interface{}{"/api/old-test"}}}, bc)
+	require.Equal(t, map[string]interface{}{"schemaVersion": "1", "paths": map[string]interface{}{"deleted": []interface{}{"/api/old-test"}}}, bc)
 }
 
 func Test_ComposedModeStdin(t *testing.T) {
@@ -361,3 +471,529 @@ func Test_Changelog_WithUnmatchPath(t *testing.T) {
 	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
 	require.Len(t, bc, 1)
 }
+
+func Test_SemverTooSmall(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff semver ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format json"), &stdout, io.Discard))
+	var semver formatters.Semver
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &semver))
+	require.Equal(t, "major", semver.RequiredBump)
+	require.Equal(t, "patch", semver.DeclaredBump)
+	require.Equal(t, "2.0.0", semver.SuggestedVersion)
+	require.False(t, semver.Valid)
+}
+
+func Test_SemverNoChanges(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff semver ../data/openapi-test1.yaml ../data/openapi-test1.yaml"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "required bump:     none")
+}
+
+func Test_SemverInvalidFormat(t *testing.T) {
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff semver ../data/openapi-test1.yaml ../data/openapi-test1.yaml --format html"), io.Discard, io.Discard))
+}
+
+func Test_BreakingStabilityPolicy(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/deprecation/base.yaml ../data/stability-policy/get-removed.yaml --stability-policy ../data/stability-policy/alpha.yaml --fail-on ERR --format json"), &stdout, io.Discard))
+	require.Equal(t, "[]\n", stdout.String())
+}
+
+func Test_BreakingInvalidStabilityPolicy(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 123, internal.Run(cmdToArgs("oasdiff breaking ../data/deprecation/base.yaml ../data/stability-policy/get-removed.yaml --stability-policy ../data/stability-policy/invalid.yaml"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), `failed to load stability policy from ../data/stability-policy/invalid.yaml: invalid rule #1`)
+}
+
+func Test_Deprecations(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff deprecations ../data/deprecation/deprecations.yaml --format json"), &stdout, io.Discard))
+	var deprecations formatters.Deprecations
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &deprecations))
+	require.Len(t, deprecations, 5)
+}
+
+func Test_DeprecationsFailOnIssues(t *testing.T) {
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff deprecations ../data/deprecation/deprecations.yaml --fail-on-issues"), io.Discard, io.Discard))
+}
+
+func Test_DeprecationsNoIssues(t *testing.T) {
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff deprecations ../data/deprecation/deprecated-future.yaml --fail-on-issues"), io.Discard, io.Discard))
+}
+
+func Test_DeprecationsInvalidFormat(t *testing.T) {
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff deprecations ../data/deprecation/deprecations.yaml --format junit"), io.Discard, io.Discard))
+}
+
+func Test_ComposedModeComponents(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/composed/components/base/*.yaml ../data/composed/components/revision/*.yaml --composed --format json"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), checker.APIComponentsSecurityRemovedId)
+}
+
+func Test_ComposedModeConflictingComponents(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/composed/components/error/base/*.yaml ../data/composed/components/error/revision/*.yaml --composed"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "conflicting definitions of 'components/schemas/Error' found in '../data/composed/components/error/revision/spec1.yaml' and '../data/composed/components/error/revision/spec2.yaml', using the first one")
+	require.Contains(t, stdout.String(), "api-removed-without-deprecation")
+}
+
+func Test_RemoteHeaders(t *testing.T) {
+	spec, err := os.ReadFile("../data/openapi-test1.yaml")
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer a,b" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write(spec)
+	}))
+	defer server.Close()
+
+	url := server.URL + "/openapi.yaml"
+	require.Equal(t, 102, internal.Run([]string{"oasdiff", "breaking", url, url}, io.Discard, io.Discard))
+	require.Zero(t, internal.Run([]string{"oasdiff", "breaking", url, url, "--http-header", "*=Authorization: Bearer a,b"}, io.Discard, io.Discard))
+}
+
+func Test_RemoteTimeout(t *testing.T) {
+	spec, err := os.ReadFile("../data/openapi-test1.yaml")
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+		_, _ = w.Write(spec)
+	}))
+	defer server.Close()
+
+	url := server.URL + "/openapi.yaml"
+	var stderr bytes.Buffer
+	require.Equal(t, 102, internal.Run([]string{"oasdiff", "flatten", url, "--http-timeout", "50ms"}, io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "Client.Timeout exceeded")
+	require.Zero(t, internal.Run([]string{"oasdiff", "flatten", url, "--http-timeout", "5s"}, io.Discard, io.Discard))
+}
+
+func Test_RemoteOffline(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 102, internal.Run(cmdToArgs("oasdiff flatten http://localhost/openapi.yaml --offline --cache-dir "+t.TempDir()), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "isn't available in offline mode")
+}
+
+func Test_RemoteInvalidOptions(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 124, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test1.yaml --offline"), io.Discard, &stderr))
+	require.Equal(t, "Error: invalid remote loading options: offline mode requires a cache directory\n", stderr.String())
+}
+
+func Test_FileURL(t *testing.T) {
+	path, err := filepath.Abs("../data/openapi-test1.yaml")
+	require.NoError(t, err)
+	url := "file://" + filepath.ToSlash(path)
+	if !strings.HasPrefix(filepath.ToSlash(path), "/") {
+		url = "file:///" + filepath.ToSlash(path)
+	}
+	require.Zero(t, internal.Run([]string{"oasdiff", "deprecations", url}, io.Discard, io.Discard))
+}
+
+func Test_MultiFileDirs(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/multi-file/base ../data/multi-file/revision --entrypoint openapi/root.yaml --format json"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), checker.ResponseOptionalPropertyRemovedId)
+}
+
+func Test_MultiFileNoEntrypoint(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 102, internal.Run(cmdToArgs("oasdiff breaking ../data/multi-file/base ../data/multi-file/revision"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "requires an entry point")
+}
+
+func Test_Bundle(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff bundle ../bundle/testdata/root.yaml --format json"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), `"$ref":"#/components/schemas/Group_2"`)
+	require.NotContains(t, stdout.String(), "yaml#")
+}
+
+func Test_BundleDereference(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff bundle ../data/multi-file/base --entrypoint openapi/root.yaml --dereference"), &stdout, io.Discard))
+	require.NotContains(t, stdout.String(), "$ref")
+}
+
+func Test_BundleInvalidFormat(t *testing.T) {
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff bundle ../bundle/testdata/root.yaml --format text"), io.Discard, io.Discard))
+}
+
+func Test_ChangelogTemplate(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format markdown --template ../data/templates/changelog-by-tag.md"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "# Tufin1 1.0.0 to 1.0.1\n")
+	require.Contains(t, stdout.String(), "## security\n- ERROR [response-success-status-removed] GET /api/{domain}/{project}/badges/security-score: removed the success response with the status '200'\n")
+	require.Contains(t, stdout.String(), "## Untagged\n")
+	require.Contains(t, stdout.String(), "## Breaking changes: 6\n")
+}
+
+func Test_ChangelogTemplateInvalidFormat(t *testing.T) {
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format json --template ../data/templates/changelog-by-tag.md"), io.Discard, io.Discard))
+}
+
+func Test_ChangelogTemplateNotFound(t *testing.T) {
+	require.Equal(t, 125, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format html --template no-such-template.html"), io.Discard, io.Discard))
+}
+
+func Test_BreakingGitLab(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format gitlab"), &stdout, io.Discard))
+	issues := []formatters.GitLabIssue{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &issues))
+	require.NotEmpty(t, issues)
+	require.Equal(t, "../data/openapi-test3.yaml", issues[0].Location.Path)
+}
+
+func Test_ChangelogAzure(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format azure"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "##vso[task.logissue type=error;code=")
+	require.Contains(t, stdout.String(), "##vso[task.setvariable variable=info_count]")
+}
+
+func Test_BreakingCheckstyle(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format checkstyle"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), `<file name="../data/openapi-test3.yaml">`)
+	require.Contains(t, stdout.String(), `severity="error"`)
+}
+
+func Test_ChecksTeamCity(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff checks --format teamcity"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "##teamcity[inspectionType id='api-path-removed-without-deprecation' name='api-path-removed-without-deprecation' category='error' description=")
+}
+
+func Test_BreakingNDJSON(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format json"), &stdout, io.Discard))
+	expected := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &expected))
+
+	stdout.Reset()
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --format ndjson"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	for _, line := range strings.Split(strings.TrimSpace(stdout.String()), "\n") {
+		var change formatters.Change
+		require.NoError(t, json.Unmarshal([]byte(line), &change))
+		bc = append(bc, change)
+	}
+	require.Equal(t, expected, bc)
+}
+
+func Test_ChecksNDJSON(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff checks --format ndjson --severity error"), &stdout, io.Discard))
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	require.NotEmpty(t, lines)
+	for _, line := range lines {
+		var check formatters.Check
+		require.NoError(t, json.Unmarshal([]byte(line), &check))
+		require.Equal(t, "error", check.Level)
+	}
+}
+
+func Test_Schema(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff schema changelog"), &stdout, io.Discard))
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &schema))
+	require.Equal(t, "oasdiff changelog output", schema["title"])
+}
+
+func Test_SchemaInvalidOutput(t *testing.T) {
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff schema invalid"), io.Discard, io.Discard))
+}
+
+func Test_SchemaVersion(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff summary ../data/openapi-test1.yaml ../data/openapi-test3.yaml -f json"), &stdout, io.Discard))
+	var summary map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &summary))
+	require.Equal(t, formatters.SchemaVersion, summary["schemaVersion"])
+}
+
+func Test_PathRenames(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff breaking ../data/path-renames/base.yaml ../data/path-renames/revision.yaml --path-renames ../data/path-renames/path-renames.yaml --fail-on ERR -f json"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 3)
+	for _, change := range bc {
+		require.Equal(t, "/users/{id}", change.Path)
+	}
+}
+
+func Test_PathRenamesInvalidFile(t *testing.T) {
+	require.Equal(t, 126, internal.Run(cmdToArgs("oasdiff breaking ../data/path-renames/base.yaml ../data/path-renames/revision.yaml --path-renames no-file"), io.Discard, io.Discard))
+}
+
+func Test_MatchByOperationId(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff breaking ../data/match-by-operation-id/base.yaml ../data/match-by-operation-id/revision.yaml --match-by operation-id --fail-on ERR -f json"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 4)
+	for _, change := range bc {
+		require.NotEqual(t, "api-removed-without-deprecation", change.Id)
+	}
+}
+
+func Test_MatchByInvalid(t *testing.T) {
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff breaking ../data/match-by-operation-id/base.yaml ../data/match-by-operation-id/revision.yaml --match-by xxx"), io.Discard, io.Discard))
+}
+
+func Test_SchemaRenameSimilarity(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff diff ../data/schema-renames/base.yaml ../data/schema-renames/revision.yaml --schema-rename-similarity 0.8 -f json"), &stdout, io.Discard))
+	d := map[string]any{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &d))
+	require.Equal(t, map[string]any{"UserDTO": "User", "OrderDTO": "Order"}, d["components"].(map[string]any)["schemas"].(map[string]any)["renamed"])
+}
+
+func Test_SchemaRenameSimilarityInvalid(t *testing.T) {
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff diff ../data/schema-renames/base.yaml ../data/schema-renames/revision.yaml --schema-rename-similarity 2"), io.Discard, io.Discard))
+}
+
+func Test_DetectPropertyRenames(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff breaking ../data/property-renames/base.yaml ../data/property-renames/revision.yaml --detect-property-renames --fail-on ERR -f json"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 2)
+	require.ElementsMatch(t, []string{"request-property-renamed", "response-property-renamed"}, []string{bc[0].Id, bc[1].Id})
+}
+
+func Test_Parallelism(t *testing.T) {
+	var sequential, parallel bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml -f json"), &sequential, io.Discard))
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml --parallelism 4 -f json"), &parallel, io.Discard))
+	require.JSONEq(t, sequential.String(), parallel.String())
+}
+
+func Test_ParallelismInvalid(t *testing.T) {
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test3.yaml --parallelism 0"), io.Discard, io.Discard))
+}
+
+func Test_BreakingIncludeChecksFromConfig(t *testing.T) {
+	data, err := filepath.Abs("../data/run_test")
+	require.NoError(t, err)
+
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.WriteFile("oasdiff.yaml", []byte("include-checks:\n  - api-tag-removed\n"), 0644))
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking "+data+"/breaking_changes_include_checks_base.yaml "+data+"/breaking_changes_include_checks_revision.yaml --format json"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 1)
+	require.Equal(t, checker.APITagRemovedId, bc[0].Id)
+}
+
+func Test_BreakingInvalidCheckerConfig(t *testing.T) {
+	data, err := filepath.Abs("../data")
+	require.NoError(t, err)
+
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.WriteFile("oasdiff.yaml", []byte("include-checks:\n  - no-such-check\n"), 0644))
+
+	var stderr bytes.Buffer
+	require.Equal(t, 122, internal.Run(cmdToArgs("oasdiff breaking "+data+"/openapi-test1.yaml "+data+"/openapi-test3.yaml"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), `invalid checker config: invalid check id: "no-such-check"`)
+}
+
+func writeProfilesConfig(t *testing.T) {
+	t.Helper()
+
+	data, err := filepath.Abs("../data/path-renames")
+	require.NoError(t, err)
+
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.WriteFile("oasdiff.yaml", []byte(fmt.Sprintf(`
+format: json
+fail-on: ERR
+profiles:
+  users:
+    base: %[1]s/base.yaml
+    revision: %[1]s/revision.yaml
+    path-renames: %[1]s/path-renames.yaml
+  users-unrenamed:
+    base: %[1]s/base.yaml
+    revision: %[1]s/revision.yaml
+    fail-on: WARN
+`, data)), 0644))
+}
+
+func Test_Profile(t *testing.T) {
+	writeProfilesConfig(t)
+
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff breaking --profile users"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 3)
+}
+
+func Test_ProfileOverrides(t *testing.T) {
+	writeProfilesConfig(t)
+
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff breaking --profile users-unrenamed --format yaml"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, yaml.Unmarshal(stdout.Bytes(), &bc))
+	require.NotEmpty(t, bc)
+}
+
+func Test_ProfileNotFound(t *testing.T) {
+	writeProfilesConfig(t)
+	require.Equal(t, 107, internal.Run(cmdToArgs("oasdiff breaking --profile invalid"), io.Discard, io.Discard))
+}
+
+func Test_NoBaseAndRevision(t *testing.T) {
+	writeProfilesConfig(t)
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff breaking"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "please specify base and revision arguments")
+}
+
+func Test_ProfileStdinWithComposed(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.WriteFile("oasdiff.yaml", []byte(`
+profiles:
+  stdin:
+    base: "-"
+    revision: "*.yaml"
+    composed: true
+`), 0644))
+
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff breaking --profile stdin"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "can't read from stdin in composed mode")
+}
+
+func writeRunAllManifest(t *testing.T) string {
+	t.Helper()
+
+	data, err := filepath.Abs("../data")
+	require.NoError(t, err)
+
+	manifest := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(manifest, []byte(fmt.Sprintf(`
+apis:
+  - name: users
+    base: %[1]s/path-renames/base.yaml
+    revision: %[1]s/path-renames/revision.yaml
+    flags:
+      path-renames: %[1]s/path-renames/path-renames.yaml
+  - name: schemas
+    base: %[1]s/schema-renames/base.yaml
+    revision: %[1]s/schema-renames/revision.yaml
+    flags:
+      include-checks: [api-operation-id-removed, api-tag-removed]
+`, data)), 0644))
+
+	return manifest
+}
+
+func Test_RunAll(t *testing.T) {
+	manifest := writeRunAllManifest(t)
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff run-all "+manifest+" --format json"), &stdout, io.Discard))
+	var output formatters.RunAllOutput
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &output))
+	require.Len(t, output.APIs, 2)
+	require.Equal(t, "users", output.APIs[0].Name)
+	require.NotEmpty(t, output.APIs[0].Changes)
+	require.Equal(t, "schemas", output.APIs[1].Name)
+	require.NotEmpty(t, output.APIs[1].Changes)
+}
+
+func Test_RunAllFailOn(t *testing.T) {
+	manifest := writeRunAllManifest(t)
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff run-all "+manifest+" --fail-on ERR --concurrency 1"), io.Discard, io.Discard))
+}
+
+func Test_RunAllJUnit(t *testing.T) {
+	manifest := writeRunAllManifest(t)
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff run-all "+manifest+" --format junit"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), `name="users"`)
+	require.Contains(t, stdout.String(), `name="schemas"`)
+}
+
+func Test_RunAllAPIError(t *testing.T) {
+	manifest := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(manifest, []byte(`
+apis:
+  - name: missing
+    base: no-such-file.yaml
+    revision: no-such-file.yaml
+`), 0644))
+
+	var stdout bytes.Buffer
+	require.Equal(t, 102, internal.Run(cmdToArgs("oasdiff run-all "+manifest+" --format json"), &stdout, io.Discard))
+	var output formatters.RunAllOutput
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &output))
+	require.Equal(t, 102, output.ExitCode)
+	require.NotEmpty(t, output.APIs[0].Error)
+}
+
+func Test_RunAllReservedFlag(t *testing.T) {
+	manifest := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(manifest, []byte(`
+apis:
+  - base: ../data/openapi-test1.yaml
+    revision: ../data/openapi-test3.yaml
+    flags:
+      format: yaml
+`), 0644))
+
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff run-all "+manifest), io.Discard, io.Discard))
+}
+
+func Test_RunAllInvalidManifest(t *testing.T) {
+	require.Equal(t, 127, internal.Run(cmdToArgs("oasdiff run-all no-such-manifest.yaml"), io.Discard, io.Discard))
+}
+
+func Test_RunAllInvalidFormat(t *testing.T) {
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff run-all manifest.yaml --format html"), io.Discard, io.Discard))
+}
+
+func Test_ProfileCPUAndMem(t *testing.T) {
+	dir := t.TempDir()
+	cpuProfile := filepath.Join(dir, "cpu.pprof")
+	memProfile := filepath.Join(dir, "mem.pprof")
+
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test3.yaml --profile-cpu "+cpuProfile+" --profile-mem "+memProfile), io.Discard, io.Discard))
+
+	for _, file := range []string{cpuProfile, memProfile} {
+		info, err := os.Stat(file)
+		require.NoError(t, err)
+		require.NotZero(t, info.Size())
+	}
+}
+
+func Test_ProfileCPUInvalidFile(t *testing.T) {
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test3.yaml --profile-cpu "+filepath.Join(t.TempDir(), "no-such-dir", "cpu.pprof")), io.Discard, io.Discard))
+}
+
+func Test_ProfileMemInvalidFile(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test3.yaml --profile-mem "+filepath.Join(t.TempDir(), "no-such-dir", "mem.pprof")), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "failed to create memory profile")
+}
+
+func Test_ProfileFlagsHidden(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff diff --help"), &stdout, io.Discard))
+	require.NotContains(t, stdout.String(), "profile-cpu")
+	require.NotContains(t, stdout.String(), "profile-mem")
+}
@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/spf13/cobra"
+)
+
+func getSchemaCmd() *cobra.Command {
+
+	cmd := cobra.Command{
+		Use:   "schema output",
+		Short: "Display the JSON schema of an output",
+		Long: `Display the JSON schema of the JSON and YAML output of a command.
+Supported outputs: ` + strings.Join(formatters.GetSchemaOutputs(), ", ") + `.
+The JSON schema is generated from the types that oasdiff uses to render the output, see the schemaVersion field in the output.`,
+		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgs: formatters.GetSchemaOutputs(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			schema, err := formatters.GetJSONSchema(args[0])
+			if err != nil {
+				return err
+			}
+
+			bytes, err := json.MarshalIndent(schema, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\n", bytes)
+			return nil
+		},
+	}
+
+	return &cmd
+}
@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/spf13/cobra"
+)
+
+const semverCmd = "semver"
+
+func getSemverCmd() *cobra.Command {
+
+	cmd := cobra.Command{
+		Use:   "semver base revision [flags]",
+		Short: "Recommend a semantic version",
+		Long: `Classify the changes between base and revision specs into a major, minor or patch version increment.
+Exits with return code 1 if the increment between the info.version of base and revision is smaller than required.` + specHelp,
+		Args: getParseArgs(),
+		RunE: getRunWithBaseAndRevision(runSemver),
+	}
+
+	addCommonDiffFlags(&cmd)
+	addCommonCheckerFlags(&cmd)
+	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputSemver), string(formatters.FormatText)), "format", "f", "output format")
+
+	return &cmd
+}
+
+func runSemver(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
+
+	errs, specInfoPair, returnErr := calcChanges(flags, checker.INFO)
+	if returnErr != nil {
+		return false, returnErr
+	}
+
+	semver := formatters.NewSemver(errs, specInfoPair)
+
+	if returnErr := outputSemver(stdout, semver, flags.getFormat()); returnErr != nil {
+		return false, returnErr
+	}
+
+	return !semver.Valid, nil
+}
+
+func outputSemver(stdout io.Writer, semver *formatters.Semver, format string) *ReturnError {
+	// formatter lookup
+	formatter, err := formatters.Lookup(format, formatters.DefaultFormatterOpts())
+	if err != nil {
+		return getErrUnsupportedFormat(format, semverCmd)
+	}
+
+	// render
+	bytes, err := formatter.RenderSemver(semver, formatters.NewRenderOpts())
+	if err != nil {
+		return getErrFailedPrint(semverCmd+" "+format, err)
+	}
+
+	// print output
+	_, _ = fmt.Fprintf(stdout, "%s\n", bytes)
+
+	return nil
+}
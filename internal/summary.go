@@ -18,7 +18,7 @@ func getSummaryCmd() *cobra.Command {
 		Short: "Generate a diff summary",
 		Long:  "Display a summary of changes between base and revision specs." + specHelp,
 		Args:  getParseArgs(),
-		RunE:  getRun(runSummary),
+		RunE:  getRunWithBaseAndRevision(runSummary),
 	}
 
 	addCommonDiffFlags(&cmd)
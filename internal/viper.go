@@ -3,6 +3,7 @@ package internal
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/checker/localizations"
@@ -20,6 +21,9 @@ type IViper interface {
 	ReadInConfig() error
 	BindPFlag(key string, flag *pflag.Flag) error
 	UnmarshalExact(rawVal any, opts ...viper.DecoderConfigOption) error
+	Get(key string) any
+	GetString(key string) string
+	MergeConfigMap(cfg map[string]any) error
 }
 
 func RunViper(cmd *cobra.Command, v IViper) *ReturnError {
@@ -27,6 +31,10 @@ func RunViper(cmd *cobra.Command, v IViper) *ReturnError {
 		return getErrConfigFileProblem(err)
 	}
 
+	if err := applyProfile(cmd, v); err != nil {
+		return getErrConfigFileProblem(err)
+	}
+
 	if err := validate(v); err != nil {
 		return getErrConfigFileProblem(err)
 	}
@@ -55,6 +63,32 @@ func readConfFile(v IViper) error {
 	return nil
 }
 
+/*
+applyProfile merges the settings of the selected profile over the top-level settings of the config file
+the top-level settings serve as the default profile which is inherited by all other profiles
+the profile is selected with the --profile flag, or with the profile setting in the config file
+*/
+func applyProfile(cmd *cobra.Command, v IViper) error {
+	profile := getProfile(cmd, v)
+	if profile == "" {
+		return nil
+	}
+
+	settings, ok := v.Get(profilesKey + "." + profile).(map[string]any)
+	if !ok {
+		return fmt.Errorf("profile %q not found", profile)
+	}
+
+	return v.MergeConfigMap(settings)
+}
+
+func getProfile(cmd *cobra.Command, v IViper) string {
+	if flag := cmd.Flags().Lookup("profile"); flag != nil && flag.Changed {
+		return flag.Value.String()
+	}
+	return v.GetString("profile")
+}
+
 func bindFlags(cmd *cobra.Command, v IViper) error {
 	var result error
 	persitentFlags := cmd.PersistentFlags()
@@ -79,34 +113,62 @@ func fixViperStringSlice(viperString []string) []string {
 	return viperString
 }
 
+const profilesKey = "profiles"
+
 type Config struct {
-	Attributes             []string `mapstructure:"attributes"`
-	Composed               bool     `mapstructure:"composed"`
-	FlattenAllof           bool     `mapstructure:"flatten-allof"`
-	FlattenParams          bool     `mapstructure:"flatten-params"`
-	CaseInsensitiveHeaders bool     `mapstructure:"case-insensitive-headers"`
-	DeprecationDaysBeta    uint     `mapstructure:"deprecation-days-beta"`
-	DeprecationDaysStable  uint     `mapstructure:"deprecation-days-stable"`
-	Lang                   string   `mapstructure:"lang"`
-	Color                  string   `mapstructure:"color"`
-	WarnIgnore             string   `mapstructure:"warn-ignore"`
-	ErrIgnore              string   `mapstructure:"err-ignore"`
-	Format                 string   `mapstructure:"format"`
-	FailOn                 string   `mapstructure:"fail-on"`
-	Level                  string   `mapstructure:"level"`
-	FailOnDiff             bool     `mapstructure:"fail-on-diff"`
-	SeverityLevels         string   `mapstructure:"severity-levels"`
-	ExcludeElements        []string `mapstructure:"exclude-elements"`
-	Severity               []string `mapstructure:"severity"`
-	Tags                   []string `mapstructure:"tags"`
-	MatchPath              string   `mapstructure:"match-path"`
-	UnmatchPath            string   `mapstructure:"unmatch-path"`
-	FilterExtension        string   `mapstructure:"filter-extension"`
-	PrefixBase             string   `mapstructure:"prefix-base"`
-	PrefixRevision         string   `mapstructure:"prefix-revision"`
-	StripPrefixBase        string   `mapstructure:"strip-prefix-base"`
-	StripPrefixRevision    string   `mapstructure:"strip-prefix-revision"`
-	IncludePathParams      bool     `mapstructure:"include-path-params"`
+	Attributes             []string       `mapstructure:"attributes"`
+	Composed               bool           `mapstructure:"composed"`
+	FlattenAllof           bool           `mapstructure:"flatten-allof"`
+	FlattenParams          bool           `mapstructure:"flatten-params"`
+	CaseInsensitiveHeaders bool           `mapstructure:"case-insensitive-headers"`
+	DeprecationDaysBeta    uint           `mapstructure:"deprecation-days-beta"`
+	DeprecationDaysStable  uint           `mapstructure:"deprecation-days-stable"`
+	Lang                   string         `mapstructure:"lang"`
+	Color                  string         `mapstructure:"color"`
+	WarnIgnore             string         `mapstructure:"warn-ignore"`
+	ErrIgnore              string         `mapstructure:"err-ignore"`
+	Format                 string         `mapstructure:"format"`
+	IncludeChecks          []string       `mapstructure:"include-checks"`
+	FailOn                 string         `mapstructure:"fail-on"`
+	Level                  string         `mapstructure:"level"`
+	FailOnDiff             bool           `mapstructure:"fail-on-diff"`
+	FailOnIssues           bool           `mapstructure:"fail-on-issues"`
+	SeverityLevels         string         `mapstructure:"severity-levels"`
+	StabilityPolicy        string         `mapstructure:"stability-policy"`
+	ExcludeElements        []string       `mapstructure:"exclude-elements"`
+	Dereference            bool           `mapstructure:"dereference"`
+	Template               string         `mapstructure:"template"`
+	Interactive            bool           `mapstructure:"interactive"`
+	Stream                 bool           `mapstructure:"stream"`
+	Entrypoint             string         `mapstructure:"entrypoint"`
+	HttpHeader             []string       `mapstructure:"http-header"`
+	HttpCert               string         `mapstructure:"http-cert"`
+	HttpKey                string         `mapstructure:"http-key"`
+	HttpCaCert             string         `mapstructure:"http-ca-cert"`
+	HttpProxy              string         `mapstructure:"http-proxy"`
+	HttpTimeout            time.Duration  `mapstructure:"http-timeout"`
+	CacheDir               string         `mapstructure:"cache-dir"`
+	Offline                bool           `mapstructure:"offline"`
+	Severity               []string       `mapstructure:"severity"`
+	Tags                   []string       `mapstructure:"tags"`
+	MatchPath              string         `mapstructure:"match-path"`
+	UnmatchPath            string         `mapstructure:"unmatch-path"`
+	FilterExtension        string         `mapstructure:"filter-extension"`
+	PrefixBase             string         `mapstructure:"prefix-base"`
+	PrefixRevision         string         `mapstructure:"prefix-revision"`
+	StripPrefixBase        string         `mapstructure:"strip-prefix-base"`
+	StripPrefixRevision    string         `mapstructure:"strip-prefix-revision"`
+	IncludePathParams      bool           `mapstructure:"include-path-params"`
+	PathRenames            string         `mapstructure:"path-renames"`
+	MatchBy                string         `mapstructure:"match-by"`
+	SchemaRenameSimilarity float64        `mapstructure:"schema-rename-similarity"`
+	DetectPropertyRenames  bool           `mapstructure:"detect-property-renames"`
+	Parallelism            int            `mapstructure:"parallelism"`
+	Concurrency            int            `mapstructure:"concurrency"`
+	Profile                string         `mapstructure:"profile"`
+	Profiles               map[string]any `mapstructure:"profiles"`
+	Base                   string         `mapstructure:"base"`
+	Revision               string         `mapstructure:"revision"`
 }
 
 // validate checks that each of the provided configuration values is one of the generally accepted values
@@ -138,6 +200,10 @@ func validate(v IViper) error {
 		return err
 	}
 
+	if err := validateString(diff.GetMatchByOptions(), config.MatchBy, "match-by"); err != nil {
+		return err
+	}
+
 	if err := validateStrings(diff.GetExcludeDiffOptions(), config.ExcludeElements, "exclude-elements"); err != nil {
 		return err
 	}
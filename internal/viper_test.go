@@ -82,7 +82,7 @@ func TestViper_InvalidFormat(t *testing.T) {
 
 	cmd := cobra.Command{}
 
-	require.EqualError(t, internal.RunViper(&cmd, v), "failed to load config file: invalid format \"invalid\", allowed values: yaml, json, text, markup, markdown, singleline, html, githubactions, junit, sarif")
+	require.EqualError(t, internal.RunViper(&cmd, v), "failed to load config file: invalid format \"invalid\", allowed values: yaml, json, text, markup, markdown, singleline, html, githubactions, junit, sarif, gitlab, azure, checkstyle, teamcity, ndjson")
 }
 
 func TestViper_InvalidFailOn(t *testing.T) {
@@ -154,3 +154,38 @@ func TestViper_InvalidFlag(t *testing.T) {
 
 	require.EqualError(t, internal.RunViper(&cmd, v), "failed to load config file: validation error: 1 error(s) decoding:\n\n* '' has invalid keys: invalid \n")
 }
+
+func TestViper_Profile(t *testing.T) {
+	v := NewViperMock()
+	v.SetConfigFile("config.yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+profile: payments
+lang: ru
+format: json
+profiles:
+  payments:
+    format: yaml
+`)))
+
+	cmd := cobra.Command{}
+
+	require.Nil(t, internal.RunViper(&cmd, v))
+	require.Equal(t, "yaml", v.GetString("format"))
+	require.Equal(t, "ru", v.GetString("lang"))
+}
+
+func TestViper_ProfileInvalidSetting(t *testing.T) {
+	v := NewViperMock()
+	v.SetConfigFile("config.yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+profiles:
+  payments:
+    lang: invalid
+`)))
+
+	cmd := cobra.Command{}
+	cmd.Flags().String("profile", "", "")
+	require.NoError(t, cmd.Flags().Set("profile", "payments"))
+
+	require.EqualError(t, internal.RunViper(&cmd, v), "failed to load config file: invalid lang \"invalid\", allowed values: en, ru")
+}
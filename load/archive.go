@@ -0,0 +1,206 @@
+package load
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+var archiveExtensions = []string{".zip", ".tar", ".tar.gz", ".tgz"}
+
+// the uncompressed size of archives is limited to protect against decompression bombs
+var (
+	maxArchiveFileSize int64 = 64 << 20
+	maxArchiveSize     int64 = 256 << 20
+)
+
+func isArchive(path string) bool {
+	lower := strings.ToLower(path)
+	for _, extension := range archiveExtensions {
+		if strings.HasSuffix(lower, extension) {
+			return true
+		}
+	}
+	return false
+}
+
+// fromDir loads the entry point of a directory, relative references are resolved within the directory
+func fromDir(loader Loader, source *Source) (*openapi3.T, error) {
+	entrypoint, err := getEntrypoint(source)
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(source.Location, entrypoint)
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("entry point %q not found in %s", source.Entrypoint, source.Location)
+	}
+
+	return loader.LoadFromFile(path)
+}
+
+// fromArchive extracts an archive to a temporary directory and loads its entry point
+func fromArchive(loader Loader, source *Source) (*openapi3.T, error) {
+	entrypoint, err := getEntrypoint(source)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "oasdiff-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := extractArchive(source.Location, dir); err != nil {
+		return nil, fmt.Errorf("failed to extract %s: %w", source.Location, err)
+	}
+
+	path := filepath.Join(dir, entrypoint)
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("entry point %q not found in %s", source.Entrypoint, source.Location)
+	}
+
+	return loader.LoadFromFile(path)
+}
+
+func getEntrypoint(source *Source) (string, error) {
+	if source.Entrypoint == "" {
+		return "", fmt.Errorf("%s requires an entry point, specify it as %s#<path> or with --entrypoint", source.Location, source.Location)
+	}
+
+	entrypoint := filepath.FromSlash(source.Entrypoint)
+	if !filepath.IsLocal(entrypoint) {
+		return "", fmt.Errorf("entry point %q must be a relative path inside %s", source.Entrypoint, source.Location)
+	}
+
+	return entrypoint, nil
+}
+
+func extractArchive(archive string, dir string) error {
+	extractor := &extractor{
+		dir:       dir,
+		remaining: maxArchiveSize,
+	}
+
+	lower := strings.ToLower(archive)
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		return extractor.extractZip(archive)
+	case strings.HasSuffix(lower, ".tar"):
+		return extractor.extractTar(archive, false)
+	default:
+		return extractor.extractTar(archive, true)
+	}
+}
+
+// extractor writes the files of an archive to dir and keeps track of the remaining size allowed for the archive
+type extractor struct {
+	dir       string
+	remaining int64
+}
+
+func (extractor *extractor) extractZip(archive string) error {
+	reader, err := zip.OpenReader(archive)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() || !file.Mode().IsRegular() {
+			continue
+		}
+
+		content, err := file.Open()
+		if err != nil {
+			return err
+		}
+		err = extractor.extractFile(file.Name, content)
+		content.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (extractor *extractor) extractTar(archive string, compressed bool) error {
+	file, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var content io.Reader = file
+	if compressed {
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		content = gzipReader
+	}
+
+	reader := tar.NewReader(content)
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if err := extractor.extractFile(header.Name, reader); err != nil {
+			return err
+		}
+	}
+}
+
+// extractFile writes a file from an archive to dir, rejecting names which would be written outside of dir and files which exceed the size limits
+func (extractor *extractor) extractFile(name string, content io.Reader) error {
+	local := filepath.FromSlash(strings.TrimPrefix(name, "./"))
+	if !filepath.IsLocal(local) {
+		return fmt.Errorf("invalid file name %q", name)
+	}
+
+	path := filepath.Join(extractor.dir, local)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	limit := min(maxArchiveFileSize, extractor.remaining)
+	written, err := io.Copy(out, io.LimitReader(content, limit+1))
+	if err != nil {
+		return err
+	}
+	if written > limit {
+		if limit == maxArchiveFileSize {
+			return fmt.Errorf("file %q exceeds the maximum size of %d bytes", name, maxArchiveFileSize)
+		}
+		return fmt.Errorf("archive exceeds the maximum uncompressed size of %d bytes", maxArchiveSize)
+	}
+	extractor.remaining -= written
+
+	return nil
+}
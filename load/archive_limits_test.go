@@ -0,0 +1,58 @@
+package load
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setArchiveLimits(t *testing.T, fileSize, size int64) {
+	t.Helper()
+	prevFileSize, prevSize := maxArchiveFileSize, maxArchiveSize
+	maxArchiveFileSize, maxArchiveSize = fileSize, size
+	t.Cleanup(func() {
+		maxArchiveFileSize, maxArchiveSize = prevFileSize, prevSize
+	})
+}
+
+func writeZipFiles(t *testing.T, files map[string]int) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "bundle.zip")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	for name, size := range files {
+		entry, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = entry.Write(bytes.Repeat([]byte{'a'}, size))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	return path
+}
+
+func TestExtractArchive_WithinLimits(t *testing.T) {
+	setArchiveLimits(t, 100, 200)
+	archive := writeZipFiles(t, map[string]int{"a.yaml": 100, "b.yaml": 100})
+	require.NoError(t, extractArchive(archive, t.TempDir()))
+}
+
+func TestExtractArchive_FileTooLarge(t *testing.T) {
+	setArchiveLimits(t, 100, 1000)
+	archive := writeZipFiles(t, map[string]int{"a.yaml": 101})
+	require.EqualError(t, extractArchive(archive, t.TempDir()), `file "a.yaml" exceeds the maximum size of 100 bytes`)
+}
+
+func TestExtractArchive_ArchiveTooLarge(t *testing.T) {
+	setArchiveLimits(t, 100, 250)
+	archive := writeZipFiles(t, map[string]int{"a.yaml": 100, "b.yaml": 100, "c.yaml": 100})
+	require.EqualError(t, extractArchive(archive, t.TempDir()), "archive exceeds the maximum uncompressed size of 250 bytes")
+}
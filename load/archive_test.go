@@ -0,0 +1,194 @@
+package load_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+const multiFileDir = "../data/multi-file/base"
+
+func newExternalRefsLoader() *openapi3.Loader {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	return loader
+}
+
+func requireGroupSchema(t *testing.T, specInfo *load.SpecInfo) {
+	t.Helper()
+	schema := specInfo.Spec.Paths.Value("/groups").Get.Responses.Value("200").Value.Content["application/json"].Schema.Value
+	require.Contains(t, schema.Properties, "name")
+}
+
+// walkFiles calls add for each file under dir with its slash separated relative path
+func walkFiles(t *testing.T, dir string, add func(name string, content []byte)) {
+	t.Helper()
+	require.NoError(t, filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		add(filepath.ToSlash(name), content)
+		return nil
+	}))
+}
+
+func writeZip(t *testing.T, dir string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "bundle.zip")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	walkFiles(t, dir, func(name string, content []byte) {
+		entry, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = entry.Write(content)
+		require.NoError(t, err)
+	})
+	require.NoError(t, writer.Close())
+
+	return path
+}
+
+func writeTarGz(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	gzipWriter := gzip.NewWriter(file)
+	writer := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		require.NoError(t, writer.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := io.WriteString(writer, content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	require.NoError(t, gzipWriter.Close())
+
+	return path
+}
+
+func readMultiFileSpec(t *testing.T) map[string]string {
+	t.Helper()
+	files := map[string]string{}
+	walkFiles(t, multiFileDir, func(name string, content []byte) {
+		files[name] = string(content)
+	})
+	return files
+}
+
+func TestSource_NewDir(t *testing.T) {
+	source := load.NewSource(multiFileDir + "#openapi/root.yaml")
+	require.Equal(t, load.SourceTypeDir, source.Type)
+	require.Equal(t, multiFileDir, source.Location)
+	require.Equal(t, "openapi/root.yaml", source.Entrypoint)
+	require.True(t, source.IsMultiFile())
+}
+
+func TestSource_NewArchive(t *testing.T) {
+	source := load.NewSource("bundle.tar.gz#openapi/root.yaml")
+	require.Equal(t, load.SourceTypeArchive, source.Type)
+	require.Equal(t, "bundle.tar.gz", source.Location)
+	require.Equal(t, "openapi/root.yaml", source.Entrypoint)
+	require.Equal(t, "bundle.tar.gz#openapi/root.yaml", source.String())
+}
+
+func TestSource_WithEntrypoint(t *testing.T) {
+	require.Equal(t, "openapi/root.yaml", load.NewSource("bundle.zip").WithEntrypoint("openapi/root.yaml").Entrypoint)
+	require.Equal(t, "root.yaml", load.NewSource("bundle.zip#root.yaml").WithEntrypoint("openapi/root.yaml").Entrypoint)
+	require.Empty(t, load.NewSource("spec.yaml").WithEntrypoint("openapi/root.yaml").Entrypoint)
+}
+
+func TestSpecInfo_Dir(t *testing.T) {
+	specInfo, err := load.NewSpecInfo(newExternalRefsLoader(), load.NewSource(multiFileDir).WithEntrypoint("openapi/root.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, multiFileDir, specInfo.Url)
+	requireGroupSchema(t, specInfo)
+}
+
+func TestSpecInfo_DirNoEntrypoint(t *testing.T) {
+	_, err := load.NewSpecInfo(newExternalRefsLoader(), load.NewSource(multiFileDir))
+	require.EqualError(t, err, "../data/multi-file/base requires an entry point, specify it as ../data/multi-file/base#<path> or with --entrypoint")
+}
+
+func TestSpecInfo_DirEntrypointNotFound(t *testing.T) {
+	_, err := load.NewSpecInfo(newExternalRefsLoader(), load.NewSource(multiFileDir+"#openapi.yaml"))
+	require.EqualError(t, err, `entry point "openapi.yaml" not found in ../data/multi-file/base`)
+}
+
+func TestSpecInfo_DirEntrypointOutside(t *testing.T) {
+	_, err := load.NewSpecInfo(newExternalRefsLoader(), load.NewSource(multiFileDir+"#../revision/openapi/root.yaml"))
+	require.EqualError(t, err, `entry point "../revision/openapi/root.yaml" must be a relative path inside ../data/multi-file/base`)
+}
+
+func TestSpecInfo_Zip(t *testing.T) {
+	archive := writeZip(t, multiFileDir)
+	specInfo, err := load.NewSpecInfo(newExternalRefsLoader(), load.NewSource(archive+"#openapi/root.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, archive+"#openapi/root.yaml", specInfo.Url)
+	requireGroupSchema(t, specInfo)
+}
+
+func TestSpecInfo_TarGz(t *testing.T) {
+	archive := writeTarGz(t, readMultiFileSpec(t))
+	specInfo, err := load.NewSpecInfo(newExternalRefsLoader(), load.NewSource(archive).WithEntrypoint("openapi/root.yaml"))
+	require.NoError(t, err)
+	requireGroupSchema(t, specInfo)
+}
+
+func TestSpecInfo_ArchiveEntrypointNotFound(t *testing.T) {
+	archive := writeZip(t, multiFileDir)
+	_, err := load.NewSpecInfo(newExternalRefsLoader(), load.NewSource(archive+"#root.yaml"))
+	require.EqualError(t, err, `entry point "root.yaml" not found in `+archive)
+}
+
+func TestSpecInfo_ArchiveInvalidFileName(t *testing.T) {
+	files := readMultiFileSpec(t)
+	files["../evil.yaml"] = "evil"
+	archive := writeTarGz(t, files)
+	_, err := load.NewSpecInfo(newExternalRefsLoader(), load.NewSource(archive+"#openapi/root.yaml"))
+	require.EqualError(t, err, "failed to extract "+archive+`: invalid file name "../evil.yaml"`)
+}
+
+func TestSpecInfo_ArchiveNotFound(t *testing.T) {
+	_, err := load.NewSpecInfo(newExternalRefsLoader(), load.NewSource("../data/no-such-bundle.zip#openapi/root.yaml"))
+	require.ErrorContains(t, err, "failed to extract ../data/no-such-bundle.zip")
+}
+
+func TestSource_NewRemoteArchive(t *testing.T) {
+	source := load.NewSource("https://example.com/bundle.zip#openapi/root.yaml")
+	require.Equal(t, load.SourceTypeURL, source.Type)
+	require.False(t, source.IsMultiFile())
+}
+
+func TestSpecInfo_RemoteArchive(t *testing.T) {
+	_, err := load.NewSpecInfo(newExternalRefsLoader(), load.NewSource("https://example.com/bundle.zip#openapi/root.yaml"))
+	require.EqualError(t, err, "remote archives aren't supported, download https://example.com/bundle.zip and load it as a local file")
+}
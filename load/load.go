@@ -3,6 +3,7 @@ package load
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 )
@@ -21,7 +22,14 @@ func from(loader Loader, source *Source) (*openapi3.T, error) {
 	case SourceTypeStdin:
 		return loader.LoadFromStdin()
 	case SourceTypeURL:
+		if archive, ok := getRemoteArchive(source.Uri); ok {
+			return nil, fmt.Errorf("remote archives aren't supported, download %s and load it as a local file", archive)
+		}
 		return loader.LoadFromURI(source.Uri)
+	case SourceTypeArchive:
+		return fromArchive(loader, source)
+	case SourceTypeDir:
+		return fromDir(loader, source)
 	default:
 		return loader.LoadFromFile(source.Path)
 	}
@@ -40,6 +48,19 @@ func getURL(rawURL string) (*url.URL, error) {
 	return url, nil
 }
 
+// getRemoteArchive returns the URL of an archive without the entry point
+func getRemoteArchive(uri *url.URL) (string, bool) {
+	location, _, _ := strings.Cut(uri.Path, "#")
+	if !isArchive(location) {
+		return "", false
+	}
+
+	archive := *uri
+	archive.Path = location
+	archive.RawPath = ""
+	return archive.Redacted(), true
+}
+
 func isValidScheme(scheme string) bool {
 
 	switch scheme {
@@ -0,0 +1,227 @@
+package load
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// RemoteOptions configures how specs and external references are read from http/s URLs
+type RemoteOptions struct {
+	// Headers are added to requests by host, each header is formatted as 'host=Name: value'
+	// Host '*' matches all hosts, environment variables in values like ${TOKEN} are expanded
+	Headers []string
+	// CertFile and KeyFile are a PEM encoded client certificate and key for mutual TLS
+	CertFile string
+	KeyFile  string
+	// CAFile is a PEM encoded certificate authority used to verify servers in addition to the system pool
+	CAFile string
+	// Proxy is the URL of a proxy server, by default the proxy is taken from the HTTP_PROXY and HTTPS_PROXY environment variables
+	Proxy string
+	// CacheDir is a directory for caching remote specs, cached specs are revalidated with ETag and Last-Modified headers
+	CacheDir string
+	// Offline reads remote specs from CacheDir only
+	Offline bool
+	// Timeout for each request, zero means no timeout
+	Timeout time.Duration
+}
+
+// NewRemoteLoader returns an OpenAPI loader which reads remote specs according to the options
+func NewRemoteLoader(opts RemoteOptions) (*openapi3.Loader, error) {
+	readFromURI, err := NewReadFromURI(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = readFromURI
+	return loader, nil
+}
+
+// NewReadFromURI returns a function which reads http/s URIs according to the options and local files as usual
+func NewReadFromURI(opts RemoteOptions) (openapi3.ReadFromURIFunc, error) {
+	headers, err := parseHeaders(opts.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Offline && opts.CacheDir == "" {
+		return nil, errors.New("offline mode requires a cache directory")
+	}
+
+	client, err := newHTTPClient(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := remoteReader{
+		client:  client,
+		headers: headers,
+		cache:   newURICache(opts.CacheDir),
+		offline: opts.Offline,
+	}
+
+	return openapi3.URIMapCache(openapi3.ReadFromURIs(remote.read, openapi3.ReadFromFile)), nil
+}
+
+// hostHeaders maps hosts to the headers that should be sent to them
+type hostHeaders map[string]http.Header
+
+const anyHost = "*"
+
+func parseHeaders(headers []string) (hostHeaders, error) {
+	result := hostHeaders{}
+	for _, header := range headers {
+		host, nameValue, ok := strings.Cut(header, "=")
+		if !ok || host == "" {
+			return nil, fmt.Errorf("invalid header %q: expected 'host=Name: value'", header)
+		}
+		name, value, ok := strings.Cut(nameValue, ":")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid header %q: expected 'host=Name: value'", header)
+		}
+
+		if result[host] == nil {
+			result[host] = http.Header{}
+		}
+		result[host].Add(strings.TrimSpace(name), os.ExpandEnv(strings.TrimSpace(value)))
+	}
+	return result, nil
+}
+
+// get returns the headers for a URL, a host may be specified with or without a port
+func (headers hostHeaders) get(location *url.URL) http.Header {
+	result := http.Header{}
+	for _, host := range []string{anyHost, location.Hostname(), location.Host} {
+		for name, values := range headers[host] {
+			result[name] = values
+		}
+	}
+	return result
+}
+
+func newHTTPClient(opts RemoteOptions) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if opts.Proxy != "" {
+		proxy, err := url.Parse(opts.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy %q: %w", opts.Proxy, err)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	if opts.CertFile != "" || opts.KeyFile != "" || opts.CAFile != "" {
+		tlsConfig, err := newTLSConfig(opts)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   opts.Timeout,
+	}, nil
+}
+
+func newTLSConfig(opts RemoteOptions) (*tls.Config, error) {
+	result := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		if opts.CertFile == "" || opts.KeyFile == "" {
+			return nil, errors.New("client certificate and key must be specified together")
+		}
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		result.Certificates = []tls.Certificate{cert}
+	}
+
+	if opts.CAFile != "" {
+		ca, err := os.ReadFile(opts.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read certificate authority: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates found in %q", opts.CAFile)
+		}
+		result.RootCAs = pool
+	}
+
+	return result, nil
+}
+
+type remoteReader struct {
+	client  *http.Client
+	headers hostHeaders
+	cache   *uriCache
+	offline bool
+}
+
+func (reader remoteReader) read(loader *openapi3.Loader, location *url.URL) ([]byte, error) {
+	if location.Scheme != "http" && location.Scheme != "https" {
+		return nil, openapi3.ErrURINotSupported
+	}
+
+	cached, hasCache := reader.cache.get(location)
+
+	if reader.offline {
+		if !hasCache {
+			return nil, fmt.Errorf("%q isn't available in offline mode because it wasn't cached", location.String())
+		}
+		return cached.body, nil
+	}
+
+	req, err := http.NewRequest(http.MethodGet, location.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = reader.headers.get(location)
+	if hasCache {
+		cached.setConditionalHeaders(req)
+	}
+
+	resp, err := reader.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && hasCache {
+		return cached.body, nil
+	}
+
+	if resp.StatusCode > 399 {
+		return nil, fmt.Errorf("error loading %q: request returned status code %d", location.String(), resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := reader.cache.put(location, resp.Header, body); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
@@ -0,0 +1,289 @@
+package load_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+const remoteSpec = `openapi: 3.0.1
+info:
+  title: Test API
+  version: v1
+paths:
+  /test:
+    get:
+      responses:
+        "200":
+          description: Success
+          content:
+            application/json:
+              schema:
+                $ref: 'schemas.yaml#/Test'
+`
+
+const remoteSchemas = `Test:
+  type: string
+`
+
+// newSpecServer returns a server with a spec and an external reference, both of which require the given token and support ETags
+func newSpecServer(t *testing.T, token string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var downloads atomic.Int32
+	files := map[string]string{
+		"/openapi.yaml": remoteSpec,
+		"/schemas.yaml": remoteSchemas,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		content, ok := files[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		etag := `"` + r.URL.Path + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		downloads.Add(1)
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(content))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &downloads
+}
+
+func loadRemote(opts load.RemoteOptions, url string) (*load.SpecInfo, error) {
+	loader, err := load.NewRemoteLoader(opts)
+	if err != nil {
+		return nil, err
+	}
+	return load.NewSpecInfo(loader, load.NewSource(url))
+}
+
+func TestRemote_Headers(t *testing.T) {
+	server, _ := newSpecServer(t, "secret")
+
+	t.Setenv("OASDIFF_TEST_TOKEN", "secret")
+	spec, err := loadRemote(load.RemoteOptions{
+		Headers: []string{"127.0.0.1=Authorization: Bearer ${OASDIFF_TEST_TOKEN}"},
+	}, server.URL+"/openapi.yaml")
+	require.NoError(t, err)
+	require.Equal(t, "string", spec.Spec.Paths.Value("/test").Get.Responses.Value("200").Value.Content["application/json"].Schema.Value.Type.Slice()[0])
+}
+
+func TestRemote_HeadersAnyHost(t *testing.T) {
+	server, _ := newSpecServer(t, "secret")
+
+	_, err := loadRemote(load.RemoteOptions{
+		Headers: []string{"*=Authorization: Bearer secret"},
+	}, server.URL+"/openapi.yaml")
+	require.NoError(t, err)
+}
+
+func TestRemote_HeadersOtherHost(t *testing.T) {
+	server, _ := newSpecServer(t, "secret")
+
+	_, err := loadRemote(load.RemoteOptions{
+		Headers: []string{"example.com=Authorization: Bearer secret"},
+	}, server.URL+"/openapi.yaml")
+	require.ErrorContains(t, err, "status code 401")
+}
+
+func TestRemote_InvalidHeader(t *testing.T) {
+	_, err := load.NewRemoteLoader(load.RemoteOptions{
+		Headers: []string{"Authorization: Bearer secret"},
+	})
+	require.EqualError(t, err, `invalid header "Authorization: Bearer secret": expected 'host=Name: value'`)
+}
+
+func TestRemote_Cache(t *testing.T) {
+	server, downloads := newSpecServer(t, "secret")
+	opts := load.RemoteOptions{
+		Headers:  []string{"*=Authorization: Bearer secret"},
+		CacheDir: t.TempDir(),
+	}
+
+	_, err := loadRemote(opts, server.URL+"/openapi.yaml")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), downloads.Load())
+
+	// cached specs are revalidated and aren't downloaded again
+	_, err = loadRemote(opts, server.URL+"/openapi.yaml")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), downloads.Load())
+}
+
+func TestRemote_Offline(t *testing.T) {
+	server, _ := newSpecServer(t, "secret")
+	cacheDir := t.TempDir()
+
+	_, err := loadRemote(load.RemoteOptions{
+		Headers:  []string{"*=Authorization: Bearer secret"},
+		CacheDir: cacheDir,
+	}, server.URL+"/openapi.yaml")
+	require.NoError(t, err)
+
+	url := server.URL
+	server.Close()
+
+	_, err = loadRemote(load.RemoteOptions{
+		CacheDir: cacheDir,
+		Offline:  true,
+	}, url+"/openapi.yaml")
+	require.NoError(t, err)
+}
+
+func TestRemote_OfflineNotCached(t *testing.T) {
+	_, err := loadRemote(load.RemoteOptions{
+		CacheDir: t.TempDir(),
+		Offline:  true,
+	}, "http://localhost/openapi.yaml")
+	require.EqualError(t, err, `"http://localhost/openapi.yaml" isn't available in offline mode because it wasn't cached`)
+}
+
+func TestRemote_OfflineWithoutCacheDir(t *testing.T) {
+	_, err := load.NewRemoteLoader(load.RemoteOptions{
+		Offline: true,
+	})
+	require.EqualError(t, err, "offline mode requires a cache directory")
+}
+
+func TestRemote_InvalidProxy(t *testing.T) {
+	_, err := load.NewRemoteLoader(load.RemoteOptions{
+		Proxy: "://proxy",
+	})
+	require.ErrorContains(t, err, `invalid proxy "://proxy"`)
+}
+
+func TestRemote_Proxy(t *testing.T) {
+	var proxied atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Add(1)
+		_, _ = w.Write([]byte(`openapi: 3.0.1
+info:
+  title: Test API
+  version: v1
+paths: {}
+`))
+	}))
+	defer proxy.Close()
+
+	_, err := loadRemote(load.RemoteOptions{
+		Proxy: proxy.URL,
+	}, "http://example.invalid/openapi.yaml")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), proxied.Load())
+}
+
+func TestRemote_KeyWithoutCert(t *testing.T) {
+	_, err := load.NewRemoteLoader(load.RemoteOptions{
+		KeyFile: "client.key",
+	})
+	require.EqualError(t, err, "client certificate and key must be specified together")
+}
+
+func TestRemote_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	clientCert := writeClientCert(t, dir)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(remoteSpec))
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	caFile := filepath.Join(dir, "ca.pem")
+	writePEM(t, caFile, "CERTIFICATE", server.Certificate().Raw)
+
+	// without a client certificate the server rejects the connection
+	_, err := loadRemote(load.RemoteOptions{
+		CAFile: caFile,
+	}, server.URL+"/openapi.yaml")
+	require.Error(t, err)
+
+	loader, err := load.NewRemoteLoader(load.RemoteOptions{
+		CertFile: filepath.Join(dir, "client.pem"),
+		KeyFile:  filepath.Join(dir, "client.key"),
+		CAFile:   caFile,
+	})
+	require.NoError(t, err)
+
+	data, err := loader.ReadFromURIFunc(loader, mustParseURL(t, server.URL+"/openapi.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, remoteSpec, string(data))
+}
+
+// writeClientCert writes a self-signed client certificate and key to dir
+func writeClientCert(t *testing.T, dir string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	writePEM(t, filepath.Join(dir, "client.pem"), "CERTIFICATE", der)
+	writePEM(t, filepath.Join(dir, "client.key"), "EC PRIVATE KEY", keyDER)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func writePEM(t *testing.T, path string, blockType string, der []byte) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+}
+
+func mustParseURL(t *testing.T, rawURL string) *url.URL {
+	t.Helper()
+	result, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	return result
+}
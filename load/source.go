@@ -3,6 +3,9 @@ package load
 import (
 	"fmt"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 )
 
 type SourceType int
@@ -11,12 +14,17 @@ const (
 	SourceTypeStdin SourceType = iota
 	SourceTypeURL
 	SourceTypeFile
+	SourceTypeArchive
+	SourceTypeDir
 )
 
 type Source struct {
 	Path string
 	Uri  *url.URL
 	Type SourceType
+	// Location is the path of the archive or directory, and Entrypoint is the root spec inside it
+	Location   string
+	Entrypoint string
 }
 
 func NewSource(path string) *Source {
@@ -27,6 +35,14 @@ func NewSource(path string) *Source {
 		}
 	}
 
+	if filePath, ok := getFilePath(path); ok {
+		return &Source{
+			Path: filePath,
+			Type: SourceTypeFile,
+		}
+	}
+
+	// URLs are checked before archives and directories so that remote paths aren't treated as local ones
 	if uri, err := getURL(path); err == nil {
 		return &Source{
 			Path: path,
@@ -35,12 +51,69 @@ func NewSource(path string) *Source {
 		}
 	}
 
+	if source, ok := getMultiFileSource(path); ok {
+		return source
+	}
+
 	return &Source{
 		Path: path,
 		Type: SourceTypeFile,
 	}
 }
 
+// getFilePath converts a file:// URL to a local path
+func getFilePath(rawURL string) (string, bool) {
+	uri, err := url.Parse(rawURL)
+	if err != nil || uri.Scheme != "file" || uri.Path == "" {
+		return "", false
+	}
+
+	if uri.Host != "" && uri.Host != "localhost" {
+		return "", false
+	}
+
+	path := uri.Path
+	// file:///C:/spec.yaml is parsed with a leading slash before the windows drive letter
+	if len(path) > 2 && path[0] == '/' && path[2] == ':' && filepath.VolumeName(path[1:]) != "" {
+		path = path[1:]
+	}
+
+	return filepath.FromSlash(path), true
+}
+
+// getMultiFileSource returns a source for an archive or a directory, optionally followed by '#' and the entry point
+func getMultiFileSource(path string) (*Source, bool) {
+	location, entrypoint, _ := strings.Cut(path, "#")
+
+	if isArchive(location) {
+		return &Source{
+			Path:       path,
+			Type:       SourceTypeArchive,
+			Location:   location,
+			Entrypoint: entrypoint,
+		}, true
+	}
+
+	if info, err := os.Stat(location); err == nil && info.IsDir() {
+		return &Source{
+			Path:       path,
+			Type:       SourceTypeDir,
+			Location:   location,
+			Entrypoint: entrypoint,
+		}, true
+	}
+
+	return nil, false
+}
+
+// WithEntrypoint sets the entry point of an archive or directory unless it was specified in the path
+func (source *Source) WithEntrypoint(entrypoint string) *Source {
+	if source.IsMultiFile() && source.Entrypoint == "" {
+		source.Entrypoint = entrypoint
+	}
+	return source
+}
+
 func (source *Source) String() string {
 	return source.Path
 }
@@ -59,3 +132,8 @@ func (source *Source) IsStdin() bool {
 func (source *Source) IsFile() bool {
 	return source.Type == SourceTypeFile
 }
+
+// IsMultiFile returns true if the source is an archive or a directory containing a spec which may be split into multiple files
+func (source *Source) IsMultiFile() bool {
+	return source.Type == SourceTypeArchive || source.Type == SourceTypeDir
+}
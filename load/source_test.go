@@ -1,6 +1,7 @@
 package load_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/oasdiff/oasdiff/load"
@@ -26,3 +27,14 @@ func TestSource_OutStdin(t *testing.T) {
 func TestSource_Out(t *testing.T) {
 	require.Equal(t, `"http://twitter.com"`, load.NewSource("http://twitter.com").Out())
 }
+
+func TestSource_NewFileURL(t *testing.T) {
+	source := load.NewSource("file://localhost/specs/openapi.yaml")
+	require.True(t, source.IsFile())
+	require.Equal(t, filepath.FromSlash("/specs/openapi.yaml"), source.Path)
+}
+
+func TestSource_NewFileURLRemoteHost(t *testing.T) {
+	require.True(t, load.NewSource("file://example.com/specs/openapi.yaml").IsFile())
+	require.Equal(t, "file://example.com/specs/openapi.yaml", load.NewSource("file://example.com/specs/openapi.yaml").Path)
+}
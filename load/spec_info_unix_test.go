@@ -3,6 +3,7 @@
 package load_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/oasdiff/oasdiff/load"
@@ -23,3 +24,11 @@ func TestLoadInfo_UriBadScheme(t *testing.T) {
 	_, err := load.NewSpecInfo(MockLoader{}, load.NewSource("ftp://localhost/null"))
 	require.EqualError(t, err, "open ftp://localhost/null: no such file or directory")
 }
+
+func TestSpecInfo_FileURL(t *testing.T) {
+	path, err := filepath.Abs("../data/openapi-test1.yaml")
+	require.NoError(t, err)
+
+	_, err = load.NewSpecInfo(MockLoader{}, load.NewSource("file://"+path))
+	require.NoError(t, err)
+}
@@ -0,0 +1,103 @@
+package load
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// uriCache stores remote specs on disk, keyed by URL, along with the headers required to revalidate them
+// a nil cache is valid and caches nothing
+type uriCache struct {
+	dir string
+}
+
+type cacheEntry struct {
+	URL          string `json:"url"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+
+	body []byte
+}
+
+func newURICache(dir string) *uriCache {
+	if dir == "" {
+		return nil
+	}
+	return &uriCache{dir: dir}
+}
+
+func (cache *uriCache) path(location *url.URL) string {
+	hash := sha256.Sum256([]byte(location.String()))
+	return filepath.Join(cache.dir, hex.EncodeToString(hash[:]))
+}
+
+// get returns the cached entry for the URL, a corrupt entry is treated as a cache miss
+func (cache *uriCache) get(location *url.URL) (*cacheEntry, bool) {
+	if cache == nil {
+		return nil, false
+	}
+
+	path := cache.path(location)
+
+	meta, err := os.ReadFile(path + ".json")
+	if err != nil {
+		return nil, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(meta, &entry); err != nil || entry.URL != location.String() {
+		return nil, false
+	}
+
+	if entry.body, err = os.ReadFile(path + ".body"); err != nil {
+		return nil, false
+	}
+
+	return &entry, true
+}
+
+func (cache *uriCache) put(location *url.URL, header http.Header, body []byte) error {
+	if cache == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(cache.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	meta, err := json.Marshal(cacheEntry{
+		URL:          location.String(),
+		ETag:         header.Get("ETag"),
+		LastModified: header.Get("Last-Modified"),
+	})
+	if err != nil {
+		return err
+	}
+
+	path := cache.path(location)
+
+	// write the body first so that an interrupted write leaves no metadata pointing to a partial body
+	if err := os.WriteFile(path+".body", body, 0o644); err != nil {
+		return fmt.Errorf("failed to write cache: %w", err)
+	}
+	if err := os.WriteFile(path+".json", meta, 0o644); err != nil {
+		return fmt.Errorf("failed to write cache: %w", err)
+	}
+
+	return nil
+}
+
+func (entry *cacheEntry) setConditionalHeaders(req *http.Request) {
+	if entry.ETag != "" {
+		req.Header.Set("If-None-Match", entry.ETag)
+	}
+	if entry.LastModified != "" {
+		req.Header.Set("If-Modified-Since", entry.LastModified)
+	}
+}
@@ -0,0 +1,505 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	_ "embed"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"gopkg.in/yaml.v3"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+//go:embed templates/interactive.html
+var interactiveHtml string
+
+// GetInteractiveHTMLReport returns a standalone HTML page with a collapsible tree of the diff
+// Elements of the tree are annotated with the given changes, which are typically the result of the checker, so that they can be filtered by level and tag
+func GetInteractiveHTMLReport(d *diff.Diff, changes checker.Changes, l checker.Localizer) (string, error) {
+	tmpl, err := template.New("interactive").Parse(interactiveHtml)
+	if err != nil {
+		return "", err
+	}
+
+	data := newInteractiveReport(d, changes, l)
+
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+type interactiveReport struct {
+	Sections []*node
+	Levels   []string
+	Tags     []string
+}
+
+// annotation is a change detected by the checker which is displayed next to the element of the diff that it refers to
+type annotation struct {
+	Id       string
+	Level    string
+	Text     string
+	Breaking bool
+}
+
+// node is an element of the diff tree, either a leaf with a value or an inner node with children
+type node struct {
+	Name        string
+	Value       string
+	Kind        string // added, deleted or modified
+	Children    []*node
+	Base        string // the base schema as YAML, for schema diffs
+	Revision    string // the revision schema as YAML, for schema diffs
+	Annotations []annotation
+	Tags        []string
+}
+
+// Levels returns the levels of the annotations of the node and its descendants
+func (n *node) Levels() []string {
+	result := []string{}
+	n.walk(func(descendant *node) {
+		for _, annotation := range descendant.Annotations {
+			if !slices.Contains(result, annotation.Level) {
+				result = append(result, annotation.Level)
+			}
+		}
+	})
+	sort.Strings(result)
+	return result
+}
+
+// Breaking returns true if the node or one of its descendants has a breaking change
+func (n *node) Breaking() bool {
+	result := false
+	n.walk(func(descendant *node) {
+		for _, annotation := range descendant.Annotations {
+			result = result || annotation.Breaking
+		}
+	})
+	return result
+}
+
+func (n *node) walk(visit func(*node)) {
+	visit(n)
+	for _, child := range n.Children {
+		child.walk(visit)
+	}
+}
+
+func newInteractiveReport(d *diff.Diff, changes checker.Changes, l checker.Localizer) *interactiveReport {
+	result := &interactiveReport{
+		Sections: []*node{},
+		Levels:   []string{},
+		Tags:     []string{},
+	}
+
+	if d.Empty() && len(changes) == 0 {
+		return result
+	}
+
+	if d == nil {
+		d = &diff.Diff{}
+	}
+
+	annotations := newAnnotations(changes, l)
+
+	if endpoints := getEndpointsNode(d, annotations); endpoints != nil {
+		result.Sections = append(result.Sections, endpoints)
+	}
+
+	if other := getOtherNode(d, annotations); other != nil {
+		result.Sections = append(result.Sections, other)
+	}
+
+	for _, section := range result.Sections {
+		section.walk(func(n *node) {
+			for _, tag := range n.Tags {
+				if !slices.Contains(result.Tags, tag) {
+					result.Tags = append(result.Tags, tag)
+				}
+			}
+		})
+		for _, level := range section.Levels() {
+			if !slices.Contains(result.Levels, level) {
+				result.Levels = append(result.Levels, level)
+			}
+		}
+	}
+	sort.Strings(result.Tags)
+	sort.Strings(result.Levels)
+
+	return result
+}
+
+// annotations groups the checker changes by the element of the diff that they refer to
+type annotations struct {
+	endpoints  map[diff.Endpoint][]annotation
+	components map[string][]annotation
+	security   []annotation
+}
+
+func newAnnotations(changes checker.Changes, l checker.Localizer) *annotations {
+	result := &annotations{
+		endpoints:  map[diff.Endpoint][]annotation{},
+		components: map[string][]annotation{},
+	}
+
+	for _, change := range changes {
+		a := annotation{
+			Id:       change.GetId(),
+			Level:    change.GetLevel().String(),
+			Text:     change.GetUncolorizedText(l),
+			Breaking: change.IsBreaking(),
+		}
+
+		switch c := change.(type) {
+		case checker.ApiChange:
+			endpoint := diff.Endpoint{Method: c.Operation, Path: c.Path}
+			result.endpoints[endpoint] = append(result.endpoints[endpoint], a)
+		case checker.ComponentChange:
+			result.components[c.Component] = append(result.components[c.Component], a)
+		case checker.SecurityChange:
+			result.security = append(result.security, a)
+		}
+	}
+
+	return result
+}
+
+func getEndpointsNode(d *diff.Diff, annotations *annotations) *node {
+	result := &node{Name: "Endpoints"}
+
+	var basePaths, revisionPaths *openapi3.Paths
+	if d.PathsDiff != nil {
+		basePaths = d.PathsDiff.Base
+		revisionPaths = d.PathsDiff.Revision
+	}
+
+	endpoints := map[diff.Endpoint]*node{}
+	if d.EndpointsDiff != nil {
+		for _, endpoint := range d.EndpointsDiff.Added {
+			endpoints[endpoint] = newEndpointNode(endpoint, "added", findOperation(revisionPaths, endpoint))
+		}
+		for _, endpoint := range d.EndpointsDiff.Deleted {
+			endpoints[endpoint] = newEndpointNode(endpoint, "deleted", findOperation(basePaths, endpoint))
+		}
+		for endpoint, methodDiff := range d.EndpointsDiff.Modified {
+			n := getNode(getEndpointName(endpoint), reflect.ValueOf(methodDiff))
+			if n == nil {
+				n = &node{Name: getEndpointName(endpoint)}
+			}
+			n.Kind = "modified"
+			n.Tags = getTags(methodDiff.Revision, methodDiff.Base)
+			endpoints[endpoint] = n
+		}
+	}
+
+	// some changes may be detected by the checker without a corresponding element in the endpoints diff
+	for endpoint, endpointAnnotations := range annotations.endpoints {
+		n, ok := endpoints[endpoint]
+		if !ok {
+			n = &node{Name: getEndpointName(endpoint), Kind: "modified", Tags: getTags(findOperation(revisionPaths, endpoint), findOperation(basePaths, endpoint))}
+			endpoints[endpoint] = n
+		}
+		n.Annotations = endpointAnnotations
+	}
+
+	for _, endpoint := range sortedEndpoints(endpoints) {
+		result.Children = append(result.Children, endpoints[endpoint])
+	}
+
+	if len(result.Children) == 0 {
+		return nil
+	}
+	return result
+}
+
+func newEndpointNode(endpoint diff.Endpoint, kind string, operation *openapi3.Operation) *node {
+	result := &node{
+		Name: getEndpointName(endpoint),
+		Kind: kind,
+		Tags: getTags(operation),
+	}
+
+	if operation == nil {
+		return result
+	}
+
+	snippet := toYAML(operation)
+	if kind == "added" {
+		result.Revision = snippet
+	} else {
+		result.Base = snippet
+	}
+
+	return result
+}
+
+func getEndpointName(endpoint diff.Endpoint) string {
+	return endpoint.Method + " " + endpoint.Path
+}
+
+func sortedEndpoints(endpoints map[diff.Endpoint]*node) []diff.Endpoint {
+	result := make([]diff.Endpoint, 0, len(endpoints))
+	for endpoint := range endpoints {
+		result = append(result, endpoint)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Path != result[j].Path {
+			return result[i].Path < result[j].Path
+		}
+		return result[i].Method < result[j].Method
+	})
+	return result
+}
+
+func findOperation(paths *openapi3.Paths, endpoint diff.Endpoint) *openapi3.Operation {
+	if paths == nil {
+		return nil
+	}
+
+	pathItem := paths.Value(endpoint.Path)
+	if pathItem == nil {
+		return nil
+	}
+
+	return pathItem.GetOperation(endpoint.Method)
+}
+
+// getTags returns the tags of the given operations without duplicates
+func getTags(operations ...*openapi3.Operation) []string {
+	result := []string{}
+	for _, operation := range operations {
+		if operation == nil {
+			continue
+		}
+		for _, tag := range operation.Tags {
+			if !slices.Contains(result, tag) {
+				result = append(result, tag)
+			}
+		}
+	}
+	return result
+}
+
+// getOtherNode returns the changes outside of paths and endpoints: info, security, components etc.
+func getOtherNode(d *diff.Diff, annotations *annotations) *node {
+	other := *d
+	other.PathsDiff = nil
+	other.EndpointsDiff = nil
+
+	result := getNode("Other Changes", reflect.ValueOf(&other))
+	if result == nil {
+		result = &node{Name: "Other Changes"}
+	}
+
+	if len(annotations.security) > 0 {
+		security := result.getOrAddChild("security")
+		security.Annotations = annotations.security
+	}
+
+	if len(annotations.components) > 0 {
+		components := result.getOrAddChild("components")
+		for _, component := range sortedKeys(annotations.components) {
+			components.getOrAddChild(component).Annotations = annotations.components[component]
+		}
+	}
+
+	if len(result.Children) == 0 {
+		return nil
+	}
+	return result
+}
+
+func (n *node) getOrAddChild(name string) *node {
+	for _, child := range n.Children {
+		if child.Name == name {
+			return child
+		}
+	}
+
+	child := &node{Name: name, Kind: "modified"}
+	n.Children = append(n.Children, child)
+	return child
+}
+
+type emptier interface {
+	Empty() bool
+}
+
+var (
+	schemaDiffType = reflect.TypeOf(diff.SchemaDiff{})
+	valueDiffType  = reflect.TypeOf(diff.ValueDiff{})
+)
+
+// getNode converts an element of the diff to a node, or returns nil if the element is empty
+func getNode(name string, v reflect.Value) *node {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		if e, ok := v.Interface().(emptier); ok && e.Empty() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if v.CanAddr() {
+		if e, ok := v.Addr().Interface().(emptier); ok && e.Empty() {
+			return nil
+		}
+	}
+
+	result := &node{Name: name, Kind: getKind(name)}
+
+	switch {
+	case v.Type() == valueDiffType:
+		valueDiff := v.Interface().(diff.ValueDiff)
+		result.Value = fmt.Sprintf("%v → %v", toString(valueDiff.From), toString(valueDiff.To))
+		result.Kind = "modified"
+		return result
+	case v.Type() == schemaDiffType && v.CanAddr():
+		schemaDiff := v.Addr().Interface().(*diff.SchemaDiff)
+		result.Base = toYAML(schemaDiff.Base)
+		result.Revision = toYAML(schemaDiff.Revision)
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		result.Children = getStructChildren(v)
+	case reflect.Map:
+		result.Children = getMapChildren(v)
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return nil
+		}
+		if isScalar(v.Type().Elem()) {
+			result.Value = joinSlice(v)
+			return result
+		}
+		for i := 0; i < v.Len(); i++ {
+			if child := getNode(fmt.Sprint(i), v.Index(i)); child != nil {
+				result.Children = append(result.Children, child)
+			}
+		}
+	default:
+		if v.IsZero() {
+			return nil
+		}
+		result.Value = toString(v.Interface())
+		return result
+	}
+
+	if len(result.Children) == 0 && result.Value == "" && result.Base == "" && result.Revision == "" {
+		return nil
+	}
+	return result
+}
+
+func getStructChildren(v reflect.Value) []*node {
+	result := []*node{}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		if child := getNode(name, v.Field(i)); child != nil {
+			result = append(result, child)
+		}
+	}
+	return result
+}
+
+func getMapChildren(v reflect.Value) []*node {
+	names := map[string]reflect.Value{}
+	for _, key := range v.MapKeys() {
+		names[getKeyName(key)] = v.MapIndex(key)
+	}
+
+	result := []*node{}
+	for _, name := range sortedKeys(names) {
+		if child := getNode(name, names[name]); child != nil {
+			result = append(result, child)
+		}
+	}
+	return result
+}
+
+func getKeyName(key reflect.Value) string {
+	if endpoint, ok := key.Interface().(diff.Endpoint); ok {
+		return getEndpointName(endpoint)
+	}
+	return fmt.Sprint(key.Interface())
+}
+
+func getKind(name string) string {
+	switch name {
+	case "added", "deleted", "modified":
+		return name
+	}
+	return ""
+}
+
+func isScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func joinSlice(v reflect.Value) string {
+	elems := make([]string, v.Len())
+	for i := range elems {
+		elems[i] = toString(v.Index(i).Interface())
+	}
+	return strings.Join(elems, ", ")
+}
+
+func toString(value any) string {
+	if value == nil {
+		return "null"
+	}
+	return fmt.Sprint(value)
+}
+
+func toYAML(value any) string {
+	if v := reflect.ValueOf(value); !v.IsValid() || v.IsNil() {
+		return ""
+	}
+
+	out, err := yaml.Marshal(value)
+	if err != nil {
+		return err.Error()
+	}
+	return string(out)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
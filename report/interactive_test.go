@@ -0,0 +1,59 @@
+package report_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/report"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInteractiveHTML(t *testing.T) {
+	d, err := diff.Get(diff.NewConfig(), l(t, 1), l(t, 3))
+	require.NoError(t, err)
+
+	changes := checker.Changes{
+		checker.ApiChange{
+			Id:        "response-success-status-removed",
+			Args:      []any{"200"},
+			Level:     checker.ERR,
+			Operation: "GET",
+			Path:      "/api/{domain}/{project}/badges/security-score",
+		},
+		checker.ComponentChange{
+			Id:        "api-schema-removed",
+			Args:      []any{"rules"},
+			Level:     checker.INFO,
+			Component: checker.ComponentSchemas,
+		},
+	}
+
+	html, err := report.GetInteractiveHTMLReport(d, changes, checker.NewDefaultLocalizer())
+	require.NoError(t, err)
+	require.Contains(t, html, `<span class="name">GET /api/{domain}/{project}/badges/security-score</span><span class="badge breaking">breaking</span><span class="badge tag">security</span>`)
+	require.Contains(t, html, `<li class="annotation level-error">removed the success response with the status &#39;200&#39; <span class="rule-id">response-success-status-removed</span></li>`)
+	require.Contains(t, html, `<li class="annotation level-info">removed the schema &#39;rules&#39; <span class="rule-id">api-schema-removed</span></li>`)
+	require.Contains(t, html, `<span class="name">operationID</span> <span class="value">GetSecurityScores → GetSecurityScore</span>`)
+	require.Contains(t, html, `<option value="security">security</option>`)
+	require.Contains(t, html, `<div class="side-by-side">`)
+}
+
+func TestInteractiveHTML_AddedEndpoint(t *testing.T) {
+	d, err := diff.Get(diff.NewConfig(), l(t, 3), l(t, 1))
+	require.NoError(t, err)
+
+	html, err := report.GetInteractiveHTMLReport(d, nil, checker.NewDefaultLocalizer())
+	require.NoError(t, err)
+	require.Contains(t, html, `<details class="node added"`)
+	require.NotContains(t, html, `class="badge breaking"`)
+}
+
+func TestInteractiveHTML_NoChanges(t *testing.T) {
+	d, err := diff.Get(diff.NewConfig(), l(t, 1), l(t, 1))
+	require.NoError(t, err)
+
+	html, err := report.GetInteractiveHTMLReport(d, nil, checker.NewDefaultLocalizer())
+	require.NoError(t, err)
+	require.Contains(t, html, "<p>No changes</p>")
+}
@@ -503,6 +503,15 @@ func (r *report) printProperties(d *diff.SchemasDiff) {
 		r.print("Deleted property:", property)
 	}
 
+	renamed := []string{}
+	for property := range d.Renamed {
+		renamed = append(renamed, property)
+	}
+	sort.Strings(renamed)
+	for _, property := range renamed {
+		r.print("Renamed property:", property, "->", d.Renamed[property])
+	}
+
 	for _, property := range getKeys(d.Modified) {
 		r.print("Modified property:", property)
 		r.indent().printSchema(d.Modified[property])
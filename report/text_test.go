@@ -104,3 +104,20 @@ func TestText_RequestBody(t *testing.T) {
 	textReport := report.GetTextReportAsString(dd)
 	require.Contains(t, textReport, "Request body changed")
 }
+
+func Test_RenamedProperties(t *testing.T) {
+	loader := openapi3.NewLoader()
+	s1, err := loader.LoadFromFile("../data/property-renames/base.yaml")
+	require.NoError(t, err)
+	s2, err := loader.LoadFromFile("../data/property-renames/revision.yaml")
+	require.NoError(t, err)
+
+	config := diff.NewConfig()
+	config.DetectPropertyRenames = true
+	dd, err := diff.Get(config, s1, s2)
+	require.NoError(t, err)
+
+	text := report.GetTextReportAsString(dd)
+	require.Contains(t, text, "Renamed property: userName -> username")
+	require.Contains(t, text, "Renamed property: zip -> postalCode")
+}
@@ -0,0 +1,4 @@
+/*
+Package specgen generates large OpenAPI specs for benchmarks and performance tests.
+*/
+package specgen
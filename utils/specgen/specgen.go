@@ -0,0 +1,161 @@
+package specgen
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Options control the size and the shape of the generated spec
+type Options struct {
+	Paths      int  // number of paths, each with GET and POST operations
+	Schemas    int  // number of component schemas, shared by the operations
+	Properties int  // number of properties of each schema, in addition to id and name
+	AllOfDepth int  // depth of the allOf chain of each schema, 0 for no allOf
+	Circular   bool // each schema references itself, like a node in a tree
+	Revision   bool // generate a revision with changes compared to the base spec, see Generate
+}
+
+// DefaultOptions returns options for a large spec with deep allOf chains and circular references
+func DefaultOptions() Options {
+	return Options{
+		Paths:      2000,
+		Schemas:    50,
+		Properties: 10,
+		AllOfDepth: 5,
+		Circular:   true,
+	}
+}
+
+// Generate generates a spec with the given options
+// Generating the base and the revision spec with the same options produces a pair of specs with changes on every path:
+// - the type of the id property of all schemas changes from integer to string
+// - an enum value is added to all properties
+// - a DELETE operation is added to each path
+// - every tenth path is deleted and replaced by a new path
+func Generate(options Options) *openapi3.T {
+	schemas := openapi3.Schemas{}
+	for i := range max(options.Schemas, 1) {
+		addSchema(schemas, options, i)
+	}
+
+	paths := openapi3.NewPaths()
+	for i := range options.Paths {
+		path := fmt.Sprintf("/resource%d", i)
+		if options.Revision && i%10 == 0 {
+			path = fmt.Sprintf("/resource%d/v2", i)
+		}
+		paths.Set(path, getPathItem(options, schemas, i))
+	}
+
+	return &openapi3.T{
+		OpenAPI:    "3.0.3",
+		Info:       &openapi3.Info{Title: "generated", Version: "1.0.0"},
+		Paths:      paths,
+		Components: &openapi3.Components{Schemas: schemas},
+	}
+}
+
+func getSchemaName(i int) string {
+	return fmt.Sprintf("Schema%d", i)
+}
+
+func getAllOfSchemaName(i, level int) string {
+	return fmt.Sprintf("Schema%dLevel%d", i, level)
+}
+
+func getRef(schemas openapi3.Schemas, name string) *openapi3.SchemaRef {
+	return openapi3.NewSchemaRef("#/components/schemas/"+name, schemas[name].Value)
+}
+
+// addSchema adds a schema to the components, along with the schemas of its allOf chain
+func addSchema(schemas openapi3.Schemas, options Options, i int) {
+	name := getSchemaName(i)
+
+	// the chain is built from the deepest level up, so that each level can reference the one below it
+	for level := options.AllOfDepth; level > 0; level-- {
+		schema := getObjectSchema(options, fmt.Sprintf("level%d", level), options.Properties/2)
+		if level < options.AllOfDepth {
+			schema = &openapi3.Schema{AllOf: openapi3.SchemaRefs{getRef(schemas, getAllOfSchemaName(i, level+1)), openapi3.NewSchemaRef("", schema)}}
+		}
+		schemas[getAllOfSchemaName(i, level)] = openapi3.NewSchemaRef("", schema)
+	}
+
+	schema := getObjectSchema(options, "field", options.Properties)
+	schema.Properties["id"] = openapi3.NewSchemaRef("", getIdSchema(options))
+	schema.Properties["name"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema().WithMaxLength(100))
+	schema.Required = []string{"id", "name"}
+
+	if options.AllOfDepth > 0 {
+		schema = &openapi3.Schema{AllOf: openapi3.SchemaRefs{getRef(schemas, getAllOfSchemaName(i, 1)), openapi3.NewSchemaRef("", schema)}}
+	}
+	schemas[name] = openapi3.NewSchemaRef("", schema)
+
+	if options.Circular {
+		properties := getProperties(schemas[name].Value)
+		properties["parent"] = getRef(schemas, name)
+		children := openapi3.NewArraySchema()
+		children.Items = getRef(schemas, name)
+		properties["children"] = openapi3.NewSchemaRef("", children)
+	}
+}
+
+// getProperties returns the properties of the object schema or of the object in its allOf
+func getProperties(schema *openapi3.Schema) openapi3.Schemas {
+	if len(schema.AllOf) > 0 {
+		return schema.AllOf[len(schema.AllOf)-1].Value.Properties
+	}
+	return schema.Properties
+}
+
+func getObjectSchema(options Options, prefix string, numOfProperties int) *openapi3.Schema {
+	enum := []any{"a", "b", "c"}
+	if options.Revision {
+		enum = append(enum, "d")
+	}
+
+	properties := openapi3.Schemas{}
+	for i := range numOfProperties {
+		properties[fmt.Sprintf("%s%d", prefix, i)] = openapi3.NewSchemaRef("", openapi3.NewStringSchema().WithEnum(enum...))
+	}
+
+	return &openapi3.Schema{
+		Type:       &openapi3.Types{openapi3.TypeObject},
+		Properties: properties,
+	}
+}
+
+func getIdSchema(options Options) *openapi3.Schema {
+	if options.Revision {
+		return openapi3.NewStringSchema()
+	}
+	return openapi3.NewIntegerSchema()
+}
+
+func getPathItem(options Options, schemas openapi3.Schemas, i int) *openapi3.PathItem {
+	ref := getRef(schemas, getSchemaName(i%max(options.Schemas, 1)))
+
+	responses := openapi3.NewResponses(openapi3.WithStatus(200, &openapi3.ResponseRef{Value: openapi3.NewResponse().WithDescription("OK").WithJSONSchemaRef(ref)}))
+
+	result := &openapi3.PathItem{
+		Get: &openapi3.Operation{
+			OperationID: fmt.Sprintf("get%d", i),
+			Parameters:  openapi3.Parameters{{Value: openapi3.NewQueryParameter("filter").WithSchema(openapi3.NewStringSchema())}},
+			Responses:   responses,
+		},
+		Post: &openapi3.Operation{
+			OperationID: fmt.Sprintf("post%d", i),
+			RequestBody: &openapi3.RequestBodyRef{Value: openapi3.NewRequestBody().WithJSONSchemaRef(ref)},
+			Responses:   responses,
+		},
+	}
+
+	if options.Revision {
+		result.Delete = &openapi3.Operation{
+			OperationID: fmt.Sprintf("delete%d", i),
+			Responses:   openapi3.NewResponses(openapi3.WithStatus(204, &openapi3.ResponseRef{Value: openapi3.NewResponse().WithDescription("Deleted")})),
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,42 @@
+package specgen_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/utils/specgen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate_Valid(t *testing.T) {
+	options := specgen.DefaultOptions()
+	options.Paths = 100
+
+	base := specgen.Generate(options)
+	require.NoError(t, base.Validate(context.Background()))
+	require.Equal(t, 100, base.Paths.Len())
+	require.Len(t, base.Components.Schemas, options.Schemas*(options.AllOfDepth+1))
+
+	options.Revision = true
+	revision := specgen.Generate(options)
+	require.NoError(t, revision.Validate(context.Background()))
+	require.NotNil(t, revision.Paths.Find("/resource0/v2"))
+	require.Nil(t, revision.Paths.Find("/resource0"))
+}
+
+func TestGenerate_Circular(t *testing.T) {
+	spec := specgen.Generate(specgen.Options{Paths: 1, Schemas: 3, Circular: true})
+	schema := spec.Components.Schemas["Schema1"]
+	require.Equal(t, "#/components/schemas/Schema1", schema.Value.Properties["parent"].Ref)
+	require.Same(t, schema.Value, schema.Value.Properties["parent"].Value)
+	require.Same(t, schema.Value, schema.Value.Properties["children"].Value.Items.Value)
+}
+
+func TestGenerate_AllOf(t *testing.T) {
+	spec := specgen.Generate(specgen.Options{Paths: 1, Schemas: 1, Properties: 2, AllOfDepth: 2})
+	schema := spec.Components.Schemas["Schema0"].Value
+	require.Len(t, schema.AllOf, 2)
+	require.Equal(t, "#/components/schemas/Schema0Level1", schema.AllOf[0].Ref)
+	require.Equal(t, "#/components/schemas/Schema0Level2", schema.AllOf[0].Value.AllOf[0].Ref)
+	require.Empty(t, schema.AllOf[0].Value.AllOf[0].Value.AllOf)
+}